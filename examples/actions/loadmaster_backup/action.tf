@@ -0,0 +1,20 @@
+resource "loadmaster_virtual_service" "this" {
+  address  = "10.0.0.1"
+  port     = 8080
+  protocol = "tcp"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update, after_update]
+      actions = [action.loadmaster_backup.this]
+    }
+  }
+}
+
+action "loadmaster_backup" "this" {
+  config {
+    directory = "${path.root}/backups"
+    prefix    = "lb01"
+    keep      = 10
+  }
+}
@@ -0,0 +1,8 @@
+# Run with: terraform apply -invoke=action.loadmaster_firmware_upgrade.this
+action "loadmaster_firmware_upgrade" "this" {
+  config {
+    file     = "${path.root}/firmware/LMOS-7.2.60.0.22514.RELEASE-Patch"
+    checksum = "0b7a5e2d8a3c2f7c9e1d4b6a8f0e3c5d7b9a1c3e5f7d9b1a3c5e7f9d1b3a5c7e"
+    timeout  = 2400
+  }
+}
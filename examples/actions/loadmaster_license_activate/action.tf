@@ -0,0 +1,6 @@
+# Run with: terraform apply -invoke=action.loadmaster_license_activate.this
+action "loadmaster_license_activate" "this" {
+  config {
+    license = file("${path.root}/license/lb01.lic")
+  }
+}
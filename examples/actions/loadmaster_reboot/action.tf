@@ -0,0 +1,16 @@
+resource "loadmaster_system_settings" "this" {
+  hostname = "lb01"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.loadmaster_reboot.this]
+    }
+  }
+}
+
+action "loadmaster_reboot" "this" {
+  config {
+    timeout = 900
+  }
+}
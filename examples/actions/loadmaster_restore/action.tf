@@ -0,0 +1,14 @@
+variable "restore_file" {
+  type        = string
+  description = "The backup archive to restore, e.g. one written by the loadmaster_backup action."
+}
+
+# Run with: terraform apply -invoke=action.loadmaster_restore.this
+action "loadmaster_restore" "this" {
+  config {
+    file    = var.restore_file
+    scope   = "vs"
+    confirm = true
+    timeout = 900
+  }
+}
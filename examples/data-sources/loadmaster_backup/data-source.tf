@@ -0,0 +1,8 @@
+data "loadmaster_backup" "example" {}
+
+check "automated_backup" {
+  assert {
+    condition     = data.loadmaster_backup.example.succeeded
+    error_message = "The last automated backup failed: ${data.loadmaster_backup.example.message}"
+  }
+}
@@ -0,0 +1 @@
+data "loadmaster_geo_filter_feed" "example" {}
@@ -0,0 +1,12 @@
+data "loadmaster_license" "this" {}
+
+output "license_expiry" {
+  value = data.loadmaster_license.this.expiry
+}
+
+resource "loadmaster_virtual_service_owasp_rule" "this" {
+  count = data.loadmaster_license.this.waf ? 1 : 0
+
+  virtual_service_id = loadmaster_virtual_service.this.id
+  rule               = "custom_rules"
+}
@@ -0,0 +1,6 @@
+data "loadmaster_real_servers" "all" {}
+
+data "loadmaster_real_servers" "disabled" {
+  virtual_service_id = "1"
+  enable             = false
+}
@@ -1,3 +1,8 @@
 data "loadmaster_sub_virtual_service" "example" {
   id = "3"
 }
+
+data "loadmaster_sub_virtual_service" "api" {
+  virtual_service_id = data.loadmaster_virtual_service.shared.id
+  nickname           = "api"
+}
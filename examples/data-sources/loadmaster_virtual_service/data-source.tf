@@ -1,3 +1,13 @@
 data "loadmaster_virtual_service" "example" {
   id = "3"
 }
+
+data "loadmaster_virtual_service" "shared" {
+  nickname = "shared-web"
+}
+
+data "loadmaster_virtual_service" "dns" {
+  address  = "10.0.0.5"
+  port     = "53"
+  protocol = "udp"
+}
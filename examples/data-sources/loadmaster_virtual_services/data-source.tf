@@ -0,0 +1,9 @@
+data "loadmaster_virtual_services" "web" {
+  nickname_regex = "^web-"
+  protocol       = "tcp"
+  enabled        = true
+}
+
+output "web_addresses" {
+  value = [for vs in data.loadmaster_virtual_services.web.virtual_services : "${vs.address}:${vs.port}"]
+}
@@ -0,0 +1,8 @@
+list "loadmaster_modify_url_rule" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    name = "^api-"
+  }
+}
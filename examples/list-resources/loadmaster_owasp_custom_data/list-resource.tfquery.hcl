@@ -0,0 +1,8 @@
+list "loadmaster_owasp_custom_data" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    filename = "\\.data$"
+  }
+}
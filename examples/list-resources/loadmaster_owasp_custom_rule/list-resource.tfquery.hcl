@@ -0,0 +1,8 @@
+list "loadmaster_owasp_custom_rule" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    filename = "\\.conf$"
+  }
+}
@@ -0,0 +1,8 @@
+list "loadmaster_real_server" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    virtual_service_id = "1"
+  }
+}
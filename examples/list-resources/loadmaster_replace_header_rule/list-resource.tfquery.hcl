@@ -0,0 +1,8 @@
+list "loadmaster_replace_header_rule" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    name = "^api-"
+  }
+}
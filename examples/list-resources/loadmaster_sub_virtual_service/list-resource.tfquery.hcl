@@ -0,0 +1,8 @@
+list "loadmaster_sub_virtual_service" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    virtual_service_id = "1"
+  }
+}
@@ -0,0 +1,9 @@
+list "loadmaster_virtual_service" "all" {
+  provider         = loadmaster
+  include_resource = true
+
+  config {
+    nickname = "^web-"
+    protocol = "tcp"
+  }
+}
@@ -1,3 +1,13 @@
 provider "loadmaster" {
   host = "https://example-loadmaster-instance.com"
 }
+
+# Provider for the first unit of a high availability pair, which connects to
+# the shared address when the unit is the standby unit.
+provider "loadmaster" {
+  alias = "lb01"
+  host  = "https://10.0.0.11"
+
+  follow_shared_address = true
+  on_standby            = "error"
+}
@@ -0,0 +1,12 @@
+resource "loadmaster_access_list" "block" {
+  type = "block"
+  entries = [
+    {
+      address = "192.0.2.0/24"
+      comment = "Incident 42"
+    },
+    {
+      address = "2001:db8::/32"
+    },
+  ]
+}
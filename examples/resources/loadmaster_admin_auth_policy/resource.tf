@@ -0,0 +1,13 @@
+resource "loadmaster_ldap_endpoint" "corp" {
+  name     = "corp"
+  servers  = ["192.0.2.30"]
+  security = "starttls"
+}
+
+resource "loadmaster_admin_auth_policy" "example" {
+  methods           = ["ldap", "local"]
+  ldap_endpoint     = loadmaster_ldap_endpoint.corp.name
+  max_sessions      = 10
+  idle_timeout      = 900
+  max_failed_logins = 5
+}
@@ -0,0 +1,17 @@
+resource "loadmaster_backup_schedule" "example" {
+  enabled          = true
+  hour             = 2
+  minute           = 30
+  day_of_week      = "daily"
+  method           = "scp"
+  host             = "backup.example.com"
+  path             = "/srv/backups/loadmaster"
+  username         = "loadmaster"
+  password         = var.backup_password
+  password_version = 1
+}
+
+variable "backup_password" {
+  type      = string
+  sensitive = true
+}
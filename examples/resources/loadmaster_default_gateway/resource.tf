@@ -0,0 +1,4 @@
+resource "loadmaster_default_gateway" "example" {
+  ipv4 = "10.0.0.1"
+  ipv6 = "2001:db8::1"
+}
@@ -0,0 +1,16 @@
+resource "loadmaster_email_notification" "example" {
+  server           = "smtp.example.com"
+  port             = 587
+  username         = "loadmaster"
+  password         = var.smtp_password
+  password_version = 1
+  security         = "starttls"
+
+  critical_recipients = ["noc@example.com", "oncall@example.com"]
+  error_recipients    = ["noc@example.com"]
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,7 @@
+resource "loadmaster_geo_cluster" "example" {
+  address   = "192.0.2.1"
+  name      = "zurich"
+  type      = "default"
+  latitude  = 47.37
+  longitude = 8.54
+}
@@ -0,0 +1,7 @@
+resource "loadmaster_geo_filter" "example" {
+  mode                   = "block"
+  countries              = ["KP", "RU"]
+  ip_reputation_blocking = true
+  auto_update            = true
+  auto_update_hour       = 3
+}
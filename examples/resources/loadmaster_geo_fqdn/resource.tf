@@ -0,0 +1,6 @@
+resource "loadmaster_geo_fqdn" "example" {
+  fqdn               = "www.example.com."
+  selection_criteria = "lb"
+  failover           = true
+  site_recovery_mode = "auto"
+}
@@ -0,0 +1,17 @@
+resource "loadmaster_geo_cluster" "example" {
+  address = "192.0.2.1"
+  name    = "zurich"
+}
+
+resource "loadmaster_geo_fqdn" "example" {
+  fqdn = "www.example.com."
+}
+
+resource "loadmaster_geo_fqdn_address" "example" {
+  fqdn         = loadmaster_geo_fqdn.example.fqdn
+  address      = "192.0.2.10"
+  cluster      = loadmaster_geo_cluster.example.address
+  weight       = 100
+  checker      = "tcp"
+  checker_port = 443
+}
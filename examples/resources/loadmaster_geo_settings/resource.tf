@@ -0,0 +1,6 @@
+resource "loadmaster_geo_settings" "example" {
+  ttl                 = 30
+  source_of_authority = "example.com."
+  name_server         = "ns1.example.com."
+  soa_email           = "hostmaster.example.com."
+}
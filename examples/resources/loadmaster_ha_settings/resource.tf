@@ -0,0 +1,31 @@
+provider "loadmaster" {
+  alias = "lb01"
+  host  = "https://10.0.0.11"
+}
+
+provider "loadmaster" {
+  alias = "lb02"
+  host  = "https://10.0.0.12"
+}
+
+resource "loadmaster_ha_settings" "lb01" {
+  provider = loadmaster.lb01
+
+  mode                   = "first"
+  shared_address         = "10.0.0.10"
+  partner_address        = "10.0.0.12"
+  heartbeat_interface_id = 0
+  switch_to_preferred    = false
+  inter_ha_tls           = true
+}
+
+resource "loadmaster_ha_settings" "lb02" {
+  provider = loadmaster.lb02
+
+  mode                   = "second"
+  shared_address         = "10.0.0.10"
+  partner_address        = "10.0.0.11"
+  heartbeat_interface_id = 0
+  switch_to_preferred    = false
+  inter_ha_tls           = true
+}
@@ -0,0 +1,4 @@
+resource "loadmaster_interface_address" "example" {
+  interface_id = 0
+  address      = "10.0.5.10/24"
+}
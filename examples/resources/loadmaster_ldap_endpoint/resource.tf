@@ -0,0 +1,15 @@
+resource "loadmaster_ldap_endpoint" "example" {
+  name                  = "corp"
+  servers               = ["192.0.2.30", "192.0.2.31:636"]
+  security              = "ldaps"
+  bind_dn               = "cn=loadmaster,ou=services,dc=example,dc=com"
+  bind_password         = var.ldap_bind_password
+  bind_password_version = 1
+  timeout               = 10
+  admin_group           = "loadmaster-admins"
+}
+
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,7 @@
+resource "loadmaster_network_interface" "example" {
+  interface_id     = 1
+  address          = "10.1.0.10/24"
+  mtu              = 1500
+  default_gateway  = false
+  admin_wui_access = false
+}
@@ -2,3 +2,8 @@ resource "loadmaster_owasp_custom_data" "name" {
   filename = "data.txt"
   data     = file("${path.module}/data.txt")
 }
+
+resource "loadmaster_owasp_custom_data" "from_file" {
+  filename = "data_from_file.txt"
+  source   = "${path.module}/data_from_file.txt"
+}
@@ -2,3 +2,13 @@ resource "loadmaster_owasp_custom_rule" "name" {
   filename = "rule.conf"
   data     = file("${path.module}/rule.conf")
 }
+
+resource "loadmaster_owasp_custom_rule" "from_file" {
+  filename = "rule_from_file.conf"
+  source   = "${path.module}/rule_from_file.conf"
+}
+
+resource "loadmaster_owasp_custom_rule" "package" {
+  filename = "package.tar.gz"
+  source   = "${path.module}/package"
+}
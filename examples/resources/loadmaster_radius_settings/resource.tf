@@ -0,0 +1,15 @@
+resource "loadmaster_radius_settings" "example" {
+  server                = "192.0.2.40"
+  port                  = 1812
+  secret                = var.radius_secret
+  backup_server         = "192.0.2.41"
+  backup_port           = 1812
+  backup_secret         = var.radius_secret
+  secret_version        = 1
+  revalidation_interval = 60
+}
+
+variable "radius_secret" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,5 @@
+# A real server can be imported by the index of its virtual service and its own index.
+terraform import loadmaster_real_server.example 12/3
+
+# Or by the address, port and protocol of its virtual service and its own address and port.
+terraform import loadmaster_real_server.example 10.0.0.4:443:tcp/10.0.0.10:8443
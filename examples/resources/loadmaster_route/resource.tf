@@ -0,0 +1,4 @@
+resource "loadmaster_route" "example" {
+  destination = "192.168.0.0/16"
+  gateway     = "10.0.0.254"
+}
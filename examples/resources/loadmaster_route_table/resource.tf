@@ -0,0 +1,13 @@
+resource "loadmaster_route_table" "example" {
+  routes = [
+    {
+      destination = "192.168.0.0/16"
+      gateway     = "10.0.0.254"
+    },
+    {
+      destination  = "172.16.0.0/12"
+      gateway      = "10.0.1.254"
+      interface_id = 1
+    },
+  ]
+}
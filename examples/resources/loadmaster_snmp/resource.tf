@@ -0,0 +1,28 @@
+resource "loadmaster_snmp" "example" {
+  enabled           = true
+  location          = "Datacenter Zurich"
+  contact           = "noc@example.com"
+  communities       = ["monitoring"]
+  trap_destinations = ["192.0.2.20"]
+
+  users = [
+    {
+      name             = "monitor"
+      auth_protocol    = "SHA"
+      auth_password    = var.snmp_auth_password
+      priv_protocol    = "AES"
+      priv_password    = var.snmp_priv_password
+      password_version = 1
+    },
+  ]
+}
+
+variable "snmp_auth_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "snmp_priv_password" {
+  type      = string
+  sensitive = true
+}
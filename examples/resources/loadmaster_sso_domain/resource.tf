@@ -0,0 +1,22 @@
+resource "loadmaster_ldap_endpoint" "corp" {
+  name     = "corp"
+  servers  = ["192.0.2.30"]
+  security = "ldaps"
+}
+
+resource "loadmaster_sso_domain" "example" {
+  name                 = "corp"
+  auth_protocol        = "ldap"
+  ldap_endpoint        = loadmaster_ldap_endpoint.corp.name
+  logon_format         = "principal_name"
+  logon_domain         = "example.com"
+  idle_timeout         = 900
+  max_session_duration = 28800
+}
+
+resource "loadmaster_sso_domain" "saml" {
+  name              = "corp-saml"
+  auth_protocol     = "saml"
+  saml_idp_metadata = file("${path.module}/idp-metadata.xml")
+  saml_sp_entity_id = "https://apps.example.com"
+}
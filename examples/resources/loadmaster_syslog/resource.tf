@@ -0,0 +1,7 @@
+resource "loadmaster_syslog" "example" {
+  error_hosts   = ["192.0.2.10"]
+  warning_hosts = ["192.0.2.10"]
+  info_hosts    = ["192.0.2.11"]
+  port          = 6514
+  transport     = "tls"
+}
@@ -0,0 +1,17 @@
+resource "loadmaster_system_settings" "example" {
+  hostname       = "lb01"
+  dns_servers    = ["192.0.2.53", "198.51.100.53"]
+  search_domains = ["example.com"]
+  ntp_servers    = ["0.pool.ntp.org", "1.pool.ntp.org"]
+  ntp_key_id     = 1
+  ntp_key_type   = "SHA-1"
+  ntp_key_secret = var.ntp_key_secret
+  timezone       = "Europe/Zurich"
+
+  ntp_key_secret_version = 1
+}
+
+variable "ntp_key_secret" {
+  type      = string
+  sensitive = true
+}
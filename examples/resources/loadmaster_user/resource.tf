@@ -0,0 +1,14 @@
+resource "loadmaster_user" "example" {
+  username         = "operator"
+  password         = var.operator_password
+  password_version = 1
+
+  real_servers     = true
+  virtual_services = true
+  rules            = true
+}
+
+variable "operator_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,16 @@
+resource "loadmaster_user" "automation" {
+  username         = "automation"
+  password         = var.automation_password
+  password_version = 1
+
+  virtual_services = true
+}
+
+resource "loadmaster_user_api_key" "example" {
+  username = loadmaster_user.automation.username
+}
+
+variable "automation_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,6 @@
+# A virtual service can be imported by its index.
+terraform import loadmaster_virtual_service.example 12
+
+# Or by its address, port and protocol, with IPv6 addresses in brackets.
+terraform import loadmaster_virtual_service.example 10.0.0.4:443:tcp
+terraform import loadmaster_virtual_service.example '[2001:db8::4]:443:tcp'
@@ -3,3 +3,28 @@ resource "loadmaster_virtual_service" "example" {
   port     = "8889"
   protocol = "tcp"
 }
+
+resource "loadmaster_virtual_service" "esp" {
+  address  = "10.0.0.4"
+  port     = "443"
+  protocol = "tcp"
+  type     = "http"
+
+  esp {
+    input_auth_mode               = "form"
+    input_sso_domain              = "corp"
+    allowed_hosts                 = ["mail.example.com"]
+    allowed_directories           = ["/owa/*"]
+    pre_auth_excluded_directories = ["/owa/auth/*"]
+    logon_form_template           = "Exchange"
+  }
+}
+
+resource "loadmaster_virtual_service" "production" {
+  address  = "10.0.0.5"
+  port     = "443"
+  protocol = "tcp"
+  type     = "http"
+
+  deletion_protection = true
+}
@@ -0,0 +1,16 @@
+resource "loadmaster_virtual_service" "example" {
+  address  = "10.0.0.4"
+  port     = "443"
+  protocol = "tcp"
+}
+
+resource "loadmaster_virtual_service_access_list" "example" {
+  virtual_service_id = loadmaster_virtual_service.example.id
+  type               = "allow"
+  entries = [
+    {
+      address = "198.51.100.0/24"
+      comment = "Office"
+    },
+  ]
+}
@@ -0,0 +1,12 @@
+resource "loadmaster_virtual_service" "example" {
+  address  = "10.0.0.4"
+  port     = "443"
+  protocol = "tcp"
+}
+
+resource "loadmaster_virtual_service_geo_filter" "example" {
+  virtual_service_id     = loadmaster_virtual_service.example.id
+  mode                   = "allow"
+  countries              = ["CH", "DE"]
+  ip_reputation_blocking = true
+}
@@ -0,0 +1,9 @@
+resource "loadmaster_vlan" "example" {
+  interface_id = 0
+  vlan_id      = 100
+}
+
+resource "loadmaster_network_interface" "example" {
+  interface_id = loadmaster_vlan.example.vlan_interface_id
+  address      = "10.100.0.10/24"
+}
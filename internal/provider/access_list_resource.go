@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &AccessListResource{}
+var _ resource.ResourceWithImportState = &AccessListResource{}
+var _ resource.ResourceWithValidateConfig = &AccessListResource{}
+var _ resource.ResourceWithIdentity = &AccessListResource{}
+
+func NewAccessListResource() resource.Resource {
+	return &AccessListResource{}
+}
+
+type AccessListResource struct {
+	client *api.Client
+}
+
+type AccessListResourceModel struct {
+	Id      types.String           `tfsdk:"id"`
+	Type    types.String           `tfsdk:"type"`
+	Entries []AccessListEntryModel `tfsdk:"entries"`
+}
+
+type AccessListEntryModel struct {
+	Address types.String `tfsdk:"address"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+func (r *AccessListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_list"
+}
+
+func (r *AccessListResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the access list, either `allow` or `block`.")
+}
+
+func (r *AccessListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global allow or block list of the LoadMaster.\n\nThis resource is authoritative, entries of the list which are not configured are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the access list, same as `type`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access list, either `allow` or `block`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": accessListEntriesSchema(),
+		},
+	}
+}
+
+func accessListEntriesSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "The entries of the access list.",
+		Required:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"address": schema.StringAttribute{
+					MarkdownDescription: "The IPv4 or IPv6 address or network in CIDR notation.",
+					Required:            true,
+				},
+				"comment": schema.StringAttribute{
+					MarkdownDescription: "A comment for the entry.",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccessListResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAccessList(data.Type, data.Entries)...)
+}
+
+func (r *AccessListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "type", data.Type)
+	tflog.Debug(ctx, "creating a resource")
+
+	list := accessListName(data.Type.ValueString())
+
+	// The resource is authoritative, so existing entries are diffed against
+	// the configuration instead of assuming an empty list.
+	current, err := r.read(ctx, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access list, got error: %s", err))
+		return
+	}
+
+	err = r.apply(ctx, list, current, data.Entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access list, got error: %s", err))
+		return
+	}
+
+	entries, err := r.read(ctx, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.Type.ValueString())
+	data.Entries = entries
+
+	tflog.Trace(ctx, "created a resource access list")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AccessListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := r.read(ctx, accessListName(data.Type.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.Type.ValueString())
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AccessListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccessListResourceModel
+	var state AccessListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list := accessListName(data.Type.ValueString())
+
+	err := r.apply(ctx, list, state.Entries, data.Entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update access list, got error: %s", err))
+		return
+	}
+
+	entries, err := r.read(ctx, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.Type.ValueString())
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, accessListName(data.Type.ValueString()), data.Entries, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete access list, got error: %s", err))
+		return
+	}
+}
+
+func (r *AccessListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data AccessListResourceModel
+
+	id := importId(ctx, req, &resp.Diagnostics)
+
+	if id != "allow" && id != "block" {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s, expected `allow` or `block`", id))
+		return
+	}
+
+	entries, err := r.read(ctx, accessListName(id))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read access list for import, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	data.Type = types.StringValue(id)
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AccessListResource) read(ctx context.Context, list string) ([]AccessListEntryModel, error) {
+	operation := ClientBackoff(func() (*api.AccessControlListResponse, error) {
+		return r.client.ListAccessControlList(list)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	return accessListEntriesFromResponse(response), nil
+}
+
+func (r *AccessListResource) apply(ctx context.Context, list string, current []AccessListEntryModel, desired []AccessListEntryModel) error {
+	return applyAccessListDiff(ctx, current, desired,
+		func(entry AccessListEntryModel) (*api.LoadMasterResponse, error) {
+			return r.client.DeleteAccessControlListAddress(list, entry.Address.ValueString())
+		},
+		func(entry AccessListEntryModel) (*api.LoadMasterResponse, error) {
+			return r.client.AddAccessControlListAddress(list, entry.Address.ValueString(), entry.Comment.ValueString())
+		},
+	)
+}
+
+// accessListName maps the access list type to the list name of the
+// LoadMaster API.
+func accessListName(t string) string {
+	if t == "allow" {
+		return "white"
+	}
+
+	return "black"
+}
+
+func accessListEntriesFromResponse(response *api.AccessControlListResponse) []AccessListEntryModel {
+	entries := []AccessListEntryModel{}
+	for _, entry := range response.Entries {
+		comment := types.StringNull()
+		if entry.Comment != "" {
+			comment = types.StringValue(entry.Comment)
+		}
+
+		entries = append(entries, AccessListEntryModel{
+			Address: types.StringValue(entry.Address),
+			Comment: comment,
+		})
+	}
+
+	return entries
+}
+
+// diffAccessListEntries returns the entries to remove and to add to turn the
+// current entries into the desired ones. Entries are identified by address,
+// an entry with a changed comment is removed and added again.
+func diffAccessListEntries(current []AccessListEntryModel, desired []AccessListEntryModel) ([]AccessListEntryModel, []AccessListEntryModel) {
+	existing := map[string]AccessListEntryModel{}
+	for _, entry := range current {
+		existing[entry.Address.ValueString()] = entry
+	}
+
+	wanted := map[string]AccessListEntryModel{}
+	for _, entry := range desired {
+		wanted[entry.Address.ValueString()] = entry
+	}
+
+	var remove []AccessListEntryModel
+	for _, entry := range current {
+		other, ok := wanted[entry.Address.ValueString()]
+		if !ok || other.Comment.ValueString() != entry.Comment.ValueString() {
+			remove = append(remove, entry)
+		}
+	}
+
+	var add []AccessListEntryModel
+	for _, entry := range desired {
+		other, ok := existing[entry.Address.ValueString()]
+		if !ok || other.Comment.ValueString() != entry.Comment.ValueString() {
+			add = append(add, entry)
+		}
+	}
+
+	return remove, add
+}
+
+func applyAccessListDiff(ctx context.Context, current []AccessListEntryModel, desired []AccessListEntryModel, remove func(AccessListEntryModel) (*api.LoadMasterResponse, error), add func(AccessListEntryModel) (*api.LoadMasterResponse, error)) error {
+	removed, added := diffAccessListEntries(current, desired)
+
+	for _, entry := range removed {
+		tflog.Debug(ctx, "removing access list entry", map[string]any{"address": entry.Address.ValueString()})
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			return remove(entry)
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			return fmt.Errorf("removing %s: %w", entry.Address.ValueString(), err)
+		}
+	}
+
+	for _, entry := range added {
+		tflog.Debug(ctx, "adding access list entry", map[string]any{"address": entry.Address.ValueString()})
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			return add(entry)
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			return fmt.Errorf("adding %s: %w", entry.Address.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func validateAccessList(t types.String, entries []AccessListEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !t.IsUnknown() && !t.IsNull() && t.ValueString() != "allow" && t.ValueString() != "block" {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Invalid Access List Type",
+			fmt.Sprintf("The type must be either `allow` or `block`, got: %s", t.ValueString()),
+		)
+	}
+
+	for _, entry := range entries {
+		if entry.Address.IsUnknown() || entry.Address.IsNull() {
+			continue
+		}
+
+		address := entry.Address.ValueString()
+		if strings.Contains(address, "/") {
+			if _, _, err := net.ParseCIDR(address); err == nil {
+				continue
+			}
+		} else if net.ParseIP(address) != nil {
+			continue
+		}
+
+		diags.AddAttributeError(
+			path.Root("entries"),
+			"Invalid Access List Address",
+			fmt.Sprintf("The address must be an IPv4 or IPv6 address or network in CIDR notation, got: %s", address),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccessListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccessListResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_access_list.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("block"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_access_list.test",
+						tfjsonpath.New("entries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("192.0.2.0/24"),
+								"comment": knownvalue.StringExact("documentation"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("2001:db8::/32"),
+								"comment": knownvalue.Null(),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_access_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:          testAccessListResourceConfig,
+				ResourceName:    "loadmaster_access_list.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				Config: testAccessListResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_access_list.test",
+						tfjsonpath.New("entries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("192.0.2.0/24"),
+								"comment": knownvalue.StringExact("changed"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestDiffAccessListEntries(t *testing.T) {
+	entry := func(address string, comment string) AccessListEntryModel {
+		return AccessListEntryModel{Address: types.StringValue(address), Comment: types.StringValue(comment)}
+	}
+
+	current := []AccessListEntryModel{
+		entry("192.0.2.1", "keep"),
+		entry("192.0.2.2", "remove"),
+		entry("192.0.2.3", "old"),
+	}
+	desired := []AccessListEntryModel{
+		entry("192.0.2.1", "keep"),
+		entry("192.0.2.3", "new"),
+		entry("192.0.2.4", "add"),
+	}
+
+	remove, add := diffAccessListEntries(current, desired)
+
+	if len(remove) != 2 || remove[0].Address.ValueString() != "192.0.2.2" || remove[1].Address.ValueString() != "192.0.2.3" {
+		t.Errorf("unexpected entries to remove: %v", remove)
+	}
+
+	if len(add) != 2 || add[0].Address.ValueString() != "192.0.2.3" || add[1].Address.ValueString() != "192.0.2.4" {
+		t.Errorf("unexpected entries to add: %v", add)
+	}
+}
+
+const testAccessListResourceConfig = `
+resource "loadmaster_access_list" "test" {
+  type = "block"
+  entries = [
+    {
+      address = "192.0.2.0/24"
+      comment = "documentation"
+    },
+    {
+      address = "2001:db8::/32"
+    },
+  ]
+}
+`
+
+const testAccessListResourceConfigUpdate = `
+resource "loadmaster_access_list" "test" {
+  type = "block"
+  entries = [
+    {
+      address = "192.0.2.0/24"
+      comment = "changed"
+    },
+  ]
+}
+`
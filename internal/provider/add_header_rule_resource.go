@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &AddHeaderRuleResource{}
 var _ resource.ResourceWithImportState = &AddHeaderRuleResource{}
+var _ resource.ResourceWithIdentity = &AddHeaderRuleResource{}
 
 func NewAddHeaderRuleResource() resource.Resource {
 	return &AddHeaderRuleResource{}
@@ -41,6 +42,10 @@ func (r *AddHeaderRuleResource) Metadata(ctx context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_add_header_rule"
 }
 
+func (r *AddHeaderRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *AddHeaderRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `AddHeaderRule`.",
@@ -64,14 +69,16 @@ func (r *AddHeaderRuleResource) Schema(ctx context.Context, req resource.SchemaR
 				Required:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 		},
 	}
@@ -133,6 +140,7 @@ func (r *AddHeaderRuleResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created a resource add header rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *AddHeaderRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -168,6 +176,7 @@ func (r *AddHeaderRuleResource) Read(ctx context.Context, req resource.ReadReque
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *AddHeaderRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -227,8 +236,10 @@ func (r *AddHeaderRuleResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *AddHeaderRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data AddHeaderRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -250,4 +261,5 @@ func (r *AddHeaderRuleResource) ImportState(ctx context.Context, req resource.Im
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &AdminAuthPolicyResource{}
+var _ resource.ResourceWithImportState = &AdminAuthPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &AdminAuthPolicyResource{}
+var _ resource.ResourceWithIdentity = &AdminAuthPolicyResource{}
+
+func NewAdminAuthPolicyResource() resource.Resource {
+	return &AdminAuthPolicyResource{}
+}
+
+type AdminAuthPolicyResource struct {
+	client *api.Client
+}
+
+type AdminAuthPolicyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Methods         types.List   `tfsdk:"methods"`
+	LdapEndpoint    types.String `tfsdk:"ldap_endpoint"`
+	MaxSessions     types.Int32  `tfsdk:"max_sessions"`
+	IdleTimeout     types.Int32  `tfsdk:"idle_timeout"`
+	MaxFailedLogins types.Int32  `tfsdk:"max_failed_logins"`
+}
+
+func (r *AdminAuthPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_auth_policy"
+}
+
+func (r *AdminAuthPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the admin auth policy, always `admin_auth_policy`.")
+}
+
+func (r *AdminAuthPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages how administrators log in to the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource resets the policy to local authentication only.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the policy, always `admin_auth_policy`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"methods": schema.ListAttribute{
+				MarkdownDescription: "The authentication methods in the order they are tried. Each of `local`, `ldap` and `radius` may be given once.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"ldap_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The name of the LDAP endpoint used for the `ldap` method.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max_sessions": schema.Int32Attribute{
+				MarkdownDescription: "The maximum number of concurrent administrator sessions, `0` means unlimited.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"idle_timeout": schema.Int32Attribute{
+				MarkdownDescription: "The time in seconds after which an idle session is logged out, between 60 and 86400.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max_failed_logins": schema.Int32Attribute{
+				MarkdownDescription: "The number of failed logins after which a user is blocked, `0` disables blocking.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AdminAuthPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AdminAuthPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AdminAuthPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	methods, diags := stringListValues(ctx, data.Methods)
+	resp.Diagnostics.Append(diags...)
+
+	if methods != nil {
+		resp.Diagnostics.Append(validateAdminAuthMethods(methods, data.LdapEndpoint)...)
+	}
+
+	if !data.IdleTimeout.IsNull() && !data.IdleTimeout.IsUnknown() {
+		if timeout := data.IdleTimeout.ValueInt32(); timeout < 60 || timeout > 86400 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("idle_timeout"),
+				"Invalid Idle Timeout",
+				fmt.Sprintf("The idle timeout must be between 60 and 86400 seconds, got: %d", timeout),
+			)
+		}
+	}
+
+	for name, value := range map[string]types.Int32{"max_sessions": data.MaxSessions, "max_failed_logins": data.MaxFailedLogins} {
+		if !value.IsNull() && !value.IsUnknown() && value.ValueInt32() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Session Limit",
+				fmt.Sprintf("The value must not be negative, got: %d", value.ValueInt32()),
+			)
+		}
+	}
+}
+
+func (r *AdminAuthPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AdminAuthPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.AdminAuthPolicyResponse, error) {
+		return r.client.ModifyAdminAuthPolicy(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create admin auth policy, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource admin auth policy")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AdminAuthPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AdminAuthPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.AdminAuthPolicyResponse, error) {
+		return r.client.ShowAdminAuthPolicy()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read admin auth policy, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AdminAuthPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AdminAuthPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.AdminAuthPolicyResponse, error) {
+		return r.client.ModifyAdminAuthPolicy(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update admin auth policy, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AdminAuthPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	operation := ClientBackoff(func() (*api.AdminAuthPolicyResponse, error) {
+		return r.client.ModifyAdminAuthPolicy(api.AdminAuthPolicyParameters{
+			Methods: []string{"local"},
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset admin auth policy, got error: %s", err))
+		return
+	}
+}
+
+func (r *AdminAuthPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data AdminAuthPolicyResourceModel
+
+	operation := ClientBackoff(func() (*api.AdminAuthPolicyResponse, error) {
+		return r.client.ShowAdminAuthPolicy()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read admin auth policy for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *AdminAuthPolicyResource) parameters(ctx context.Context, data AdminAuthPolicyResourceModel) (api.AdminAuthPolicyParameters, diag.Diagnostics) {
+	methods, diags := stringListValues(ctx, data.Methods)
+
+	return api.AdminAuthPolicyParameters{
+		Methods:         methods,
+		LdapEndpoint:    data.LdapEndpoint.ValueString(),
+		MaxSessions:     data.MaxSessions.ValueInt32Pointer(),
+		IdleTimeout:     data.IdleTimeout.ValueInt32Pointer(),
+		MaxFailedLogins: data.MaxFailedLogins.ValueInt32Pointer(),
+	}, diags
+}
+
+func (r *AdminAuthPolicyResource) fromResponse(ctx context.Context, data *AdminAuthPolicyResourceModel, response *api.AdminAuthPolicyResponse) diag.Diagnostics {
+	methods, diags := stringListValue(ctx, response.Methods)
+
+	data.Id = types.StringValue("admin_auth_policy")
+	data.Methods = methods
+	data.LdapEndpoint = types.StringValue(response.LdapEndpoint)
+	data.MaxSessions = types.Int32Value(response.MaxSessions)
+	data.IdleTimeout = types.Int32Value(response.IdleTimeout)
+	data.MaxFailedLogins = types.Int32Value(response.MaxFailedLogins)
+
+	return diags
+}
+
+// validateAdminAuthMethods checks the configured login methods and warns
+// about configurations which may lock administrators out.
+func validateAdminAuthMethods(methods []string, ldapEndpoint types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(methods) == 0 {
+		diags.AddAttributeError(
+			path.Root("methods"),
+			"Missing Authentication Method",
+			"At least one authentication method must be configured.",
+		)
+	}
+
+	for i, method := range methods {
+		switch method {
+		case "local", "ldap", "radius":
+		default:
+			diags.AddAttributeError(
+				path.Root("methods").AtListIndex(i),
+				"Invalid Authentication Method",
+				fmt.Sprintf("The method must be one of `local`, `ldap` or `radius`, got: %s", method),
+			)
+		}
+
+		if slices.Index(methods, method) != i {
+			diags.AddAttributeError(
+				path.Root("methods").AtListIndex(i),
+				"Duplicate Authentication Method",
+				fmt.Sprintf("The method %s is given more than once.", method),
+			)
+		}
+	}
+
+	if slices.Contains(methods, "ldap") && ldapEndpoint.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ldap_endpoint"),
+			"Missing LDAP Endpoint",
+			"An LDAP endpoint must be configured when the `ldap` method is used.",
+		)
+	}
+
+	if len(methods) > 0 && !slices.Contains(methods, "local") {
+		diags.AddAttributeWarning(
+			path.Root("methods"),
+			"Local Authentication Disabled",
+			"Without the `local` method administrators cannot log in when the remote authentication servers are unreachable.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAdminAuthPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAdminAuthPolicyResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_admin_auth_policy.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("admin_auth_policy"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_admin_auth_policy.test",
+						tfjsonpath.New("methods"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("ldap"),
+							knownvalue.StringExact("local"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_admin_auth_policy.test",
+						tfjsonpath.New("ldap_endpoint"),
+						knownvalue.StringExact("tfpolicy"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_admin_auth_policy.test",
+				ImportState:       true,
+				ImportStateId:     "admin_auth_policy",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAdminAuthPolicyResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_admin_auth_policy.test",
+						tfjsonpath.New("idle_timeout"),
+						knownvalue.Int32Exact(600),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateAdminAuthMethods(t *testing.T) {
+	cases := []struct {
+		methods  []string
+		endpoint types.String
+		errors   int
+		warnings int
+	}{
+		{[]string{"local"}, types.StringNull(), 0, 0},
+		{[]string{"ldap", "local"}, types.StringValue("corp"), 0, 0},
+		{[]string{"ldap", "local"}, types.StringNull(), 1, 0},
+		{[]string{"radius"}, types.StringNull(), 0, 1},
+		{[]string{"local", "local"}, types.StringNull(), 1, 0},
+		{[]string{"kerberos"}, types.StringNull(), 1, 1},
+		{[]string{}, types.StringNull(), 1, 0},
+	}
+
+	for _, c := range cases {
+		diags := validateAdminAuthMethods(c.methods, c.endpoint)
+		if diags.ErrorsCount() != c.errors || diags.WarningsCount() != c.warnings {
+			t.Errorf("validateAdminAuthMethods(%v) returned %d errors and %d warnings, expected %d and %d", c.methods, diags.ErrorsCount(), diags.WarningsCount(), c.errors, c.warnings)
+		}
+	}
+}
+
+const testAdminAuthPolicyResourceConfig = `
+resource "loadmaster_ldap_endpoint" "test" {
+  name = "tfpolicy"
+  servers = ["192.0.2.30"]
+}
+
+resource "loadmaster_admin_auth_policy" "test" {
+  methods = ["ldap", "local"]
+  ldap_endpoint = loadmaster_ldap_endpoint.test.name
+}
+`
+
+const testAdminAuthPolicyResourceConfigUpdate = `
+resource "loadmaster_ldap_endpoint" "test" {
+  name = "tfpolicy"
+  servers = ["192.0.2.30"]
+}
+
+resource "loadmaster_admin_auth_policy" "test" {
+  methods = ["ldap", "local"]
+  ldap_endpoint = loadmaster_ldap_endpoint.test.name
+  idle_timeout = 600
+}
+`
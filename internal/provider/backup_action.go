@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+// backupTimestampFormat is the format of the timestamp in the archive names.
+const backupTimestampFormat = "20060102T150405Z"
+
+var _ action.Action = &BackupAction{}
+var _ action.ActionWithConfigure = &BackupAction{}
+var _ action.ActionWithValidateConfig = &BackupAction{}
+
+func NewBackupAction() action.Action {
+	return &BackupAction{}
+}
+
+type BackupAction struct {
+	client *api.Client
+}
+
+type BackupActionModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Keep      types.Int32  `tfsdk:"keep"`
+}
+
+func (a *BackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (a *BackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This action creates a full configuration backup of the LoadMaster and writes the archive to a local directory. " +
+			"The archive is named `<prefix>-<timestamp>.tar.gz`, its SHA-256 checksum is written next to it into a `.sha256` file.",
+		Attributes: map[string]schema.Attribute{
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "The local directory the archive is written to. The directory is created if it does not exist.",
+				Required:            true,
+			},
+			"prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the archive name. Defaults to `loadmaster`.",
+				Optional:            true,
+			},
+			"keep": schema.Int32Attribute{
+				MarkdownDescription: "The number of archives with the same prefix to keep in the directory. Older archives are removed. By default all archives are kept.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *BackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *BackupAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data BackupActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Keep.IsNull() && !data.Keep.IsUnknown() && data.Keep.ValueInt32() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keep"),
+			"Invalid Backup Retention",
+			fmt.Sprintf("At least one backup must be kept, got: %d", data.Keep.ValueInt32()),
+		)
+	}
+
+	if !data.Prefix.IsNull() && !data.Prefix.IsUnknown() && (data.Prefix.ValueString() == "" || strings.ContainsAny(data.Prefix.ValueString(), `/\`)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("prefix"),
+			"Invalid Backup Prefix",
+			fmt.Sprintf("The prefix must not be empty or contain path separators, got: %s", data.Prefix.ValueString()),
+		)
+	}
+}
+
+func (a *BackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data BackupActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := "loadmaster"
+	if !data.Prefix.IsNull() {
+		prefix = data.Prefix.ValueString()
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Creating backup of the LoadMaster"})
+
+	operation := ClientBackoff(func() (*api.BackupResponse, error) {
+		return a.client.CreateBackup()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating backup",
+			fmt.Sprintf("Could not create backup of the LoadMaster: %s", err.Error()),
+		)
+		return
+	}
+
+	file, checksum, err := writeBackup(data.Directory.ValueString(), prefix, time.Now(), response.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error writing backup",
+			fmt.Sprintf("Could not write backup to %s: %s", data.Directory.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Wrote backup", map[string]any{"file": file, "size": len(response.Data), "sha256": checksum})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Wrote backup %s (%d bytes, sha256 %s)", file, len(response.Data), checksum),
+	})
+
+	if data.Keep.IsNull() {
+		return
+	}
+
+	removed, err := pruneBackups(data.Directory.ValueString(), prefix, int(data.Keep.ValueInt32()))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Error removing old backups",
+			fmt.Sprintf("The backup was written, but older backups could not be removed: %s", err.Error()),
+		)
+		return
+	}
+
+	for _, file := range removed {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Removed old backup %s", file)})
+	}
+}
+
+// writeBackup writes the archive and its checksum file to the directory and
+// returns the path of the archive and its SHA-256 checksum. The archive is
+// written to a temporary file first so an interrupted write does not leave a
+// truncated archive behind.
+func writeBackup(directory string, prefix string, now time.Time, archive []byte) (string, string, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return "", "", err
+	}
+
+	name := fmt.Sprintf("%s-%s.tar.gz", prefix, now.UTC().Format(backupTimestampFormat))
+	file := filepath.Join(directory, name)
+
+	tmp, err := os.CreateTemp(directory, "."+name+".*")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(archive); err != nil {
+		tmp.Close()
+		return "", "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", "", err
+	}
+	if err := os.Rename(tmp.Name(), file); err != nil {
+		return "", "", err
+	}
+
+	sum := sha256.Sum256(archive)
+	checksum := hex.EncodeToString(sum[:])
+
+	if err := os.WriteFile(file+".sha256", []byte(checksum+"  "+name+"\n"), 0o600); err != nil {
+		return "", "", err
+	}
+
+	return file, checksum, nil
+}
+
+// pruneBackups removes all but the newest keep archives with the prefix from
+// the directory, together with their checksum files. The archive names contain
+// the timestamp, so sorting them by name sorts them by age.
+func pruneBackups(directory string, prefix string, keep int) ([]string, error) {
+	matches, err := filepath.Glob(filepath.Join(directory, prefix+"-*.tar.gz"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Skip archives of a longer prefix, e.g. `loadmaster-dr-...` for `loadmaster`.
+	archives := []string{}
+	for _, match := range matches {
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(match), prefix+"-"), ".tar.gz")
+		if _, err := time.Parse(backupTimestampFormat, timestamp); err == nil {
+			archives = append(archives, match)
+		}
+	}
+
+	if len(archives) <= keep {
+		return nil, nil
+	}
+
+	slices.Sort(archives)
+
+	removed := []string{}
+	for _, archive := range archives[:len(archives)-keep] {
+		if err := os.Remove(archive); err != nil {
+			return removed, err
+		}
+		if err := os.Remove(archive + ".sha256"); err != nil && !os.IsNotExist(err) {
+			return removed, err
+		}
+		removed = append(removed, archive)
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestBackupAction(t *testing.T) {
+	directory := t.TempDir()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testBackupActionConfig(directory),
+				Check: func(s *terraform.State) error {
+					archives, err := filepath.Glob(filepath.Join(directory, "tftest-*.tar.gz"))
+					if err != nil {
+						return err
+					}
+					if len(archives) != 1 {
+						return fmt.Errorf("expected one archive, got %d", len(archives))
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestWriteBackup(t *testing.T) {
+	directory := filepath.Join(t.TempDir(), "backups")
+	archive := []byte("archive")
+	now := time.Date(2026, 10, 18, 12, 30, 0, 0, time.UTC)
+
+	file, checksum, err := writeBackup(directory, "lb01", now, archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := filepath.Join(directory, "lb01-20261018T123000Z.tar.gz"); file != expected {
+		t.Errorf("expected archive %s, got %s", expected, file)
+	}
+
+	sum := sha256.Sum256(archive)
+	if expected := hex.EncodeToString(sum[:]); checksum != expected {
+		t.Errorf("expected checksum %s, got %s", expected, checksum)
+	}
+
+	content, err := os.ReadFile(file + ".sha256")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := checksum + "  lb01-20261018T123000Z.tar.gz\n"; string(content) != expected {
+		t.Errorf("expected checksum file %q, got %q", expected, content)
+	}
+
+	entries, _ := os.ReadDir(directory)
+	if len(entries) != 2 {
+		t.Errorf("expected no temporary files to be left, got %d entries", len(entries))
+	}
+}
+
+func TestPruneBackups(t *testing.T) {
+	directory := t.TempDir()
+	start := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	for i := range 4 {
+		if _, _, err := writeBackup(directory, "lb01", start.Add(time.Duration(i)*time.Hour), []byte("archive")); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if _, _, err := writeBackup(directory, "lb01-dr", start, []byte("archive")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	removed, err := pruneBackups(directory, "lb01", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		filepath.Join(directory, "lb01-20261018T000000Z.tar.gz"),
+		filepath.Join(directory, "lb01-20261018T010000Z.tar.gz"),
+	}
+	if !slices.Equal(removed, expected) {
+		t.Errorf("expected %v to be removed, got %v", expected, removed)
+	}
+
+	remaining, _ := filepath.Glob(filepath.Join(directory, "*.tar.gz*"))
+	if len(remaining) != 6 {
+		t.Errorf("expected 3 archives with checksums to remain, got %v", remaining)
+	}
+}
+
+func testBackupActionConfig(directory string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_virtual_service" "test" {
+  address = "10.0.0.4"
+  port = "9095"
+  protocol = "tcp"
+
+  lifecycle {
+    action_trigger {
+      events = [after_create]
+      actions = [action.loadmaster_backup.this]
+    }
+  }
+}
+
+action "loadmaster_backup" "this" {
+  config {
+    directory = %q
+    prefix = "tftest"
+    keep = 1
+  }
+}
+`, directory)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var (
+	_ datasource.DataSource              = &BackupDataSource{}
+	_ datasource.DataSourceWithConfigure = &BackupDataSource{}
+)
+
+func NewBackupDataSource() datasource.DataSource {
+	return &BackupDataSource{}
+}
+
+type BackupDataSource struct {
+	client *api.Client
+}
+
+type BackupDataSourceModel struct {
+	LastRun   types.String `tfsdk:"last_run"`
+	Succeeded types.Bool   `tfsdk:"succeeded"`
+	Message   types.String `tfsdk:"message"`
+}
+
+func (d *BackupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup"
+}
+
+func (d *BackupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to retrieve the result of the last automated backup of the LoadMaster.",
+
+		Attributes: map[string]schema.Attribute{
+			"last_run": schema.StringAttribute{
+				MarkdownDescription: "The time the last automated backup ran, empty if no backup ran yet.",
+				Computed:            true,
+			},
+			"succeeded": schema.BoolAttribute{
+				MarkdownDescription: "Whether the last automated backup succeeded.",
+				Computed:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The message reported by the last automated backup, e.g. the reason it failed.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *BackupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.BackupStatusResponse, error) {
+		return d.client.ShowBackupStatus()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup status, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.LastRun = types.StringValue(response.LastRun)
+	data.Succeeded = types.BoolValue(response.Succeeded)
+	data.Message = types.StringValue(response.Message)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &BackupScheduleResource{}
+var _ resource.ResourceWithImportState = &BackupScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &BackupScheduleResource{}
+var _ resource.ResourceWithIdentity = &BackupScheduleResource{}
+
+func NewBackupScheduleResource() resource.Resource {
+	return &BackupScheduleResource{}
+}
+
+type BackupScheduleResource struct {
+	client *api.Client
+}
+
+type BackupScheduleResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Hour            types.Int32  `tfsdk:"hour"`
+	Minute          types.Int32  `tfsdk:"minute"`
+	DayOfWeek       types.String `tfsdk:"day_of_week"`
+	Method          types.String `tfsdk:"method"`
+	Host            types.String `tfsdk:"host"`
+	Path            types.String `tfsdk:"path"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int32  `tfsdk:"password_version"`
+}
+
+func (r *BackupScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_schedule"
+}
+
+func (r *BackupScheduleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the backup schedule, always `backup_schedule`.")
+}
+
+func (r *BackupScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the automated backups of the LoadMaster to a remote host.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource disables the automated backups.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the schedule, always `backup_schedule`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether automated backups are enabled.",
+				Required:            true,
+			},
+			"hour": schema.Int32Attribute{
+				MarkdownDescription: "The hour of the day the backup runs, between 0 and 23.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"minute": schema.Int32Attribute{
+				MarkdownDescription: "The minute of the hour the backup runs, between 0 and 59.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"day_of_week": schema.StringAttribute{
+				MarkdownDescription: "The day the backup runs, either `daily` or a day of the week like `monday`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"method": schema.StringAttribute{
+				MarkdownDescription: "The transfer method, one of `ftp`, `scp` or `sftp`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The remote host the backups are transferred to, as `address` or `address:port`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The directory on the remote host.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to log in to the remote host.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to log in to the remote host. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `password` to the LoadMaster again.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *BackupScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BackupScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BackupScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Hour.IsNull() && !data.Hour.IsUnknown() && (data.Hour.ValueInt32() < 0 || data.Hour.ValueInt32() > 23) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hour"),
+			"Invalid Backup Hour",
+			fmt.Sprintf("The hour must be between 0 and 23, got: %d", data.Hour.ValueInt32()),
+		)
+	}
+
+	if !data.Minute.IsNull() && !data.Minute.IsUnknown() && (data.Minute.ValueInt32() < 0 || data.Minute.ValueInt32() > 59) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minute"),
+			"Invalid Backup Minute",
+			fmt.Sprintf("The minute must be between 0 and 59, got: %d", data.Minute.ValueInt32()),
+		)
+	}
+
+	if !data.DayOfWeek.IsNull() && !data.DayOfWeek.IsUnknown() {
+		switch data.DayOfWeek.ValueString() {
+		case "daily", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("day_of_week"),
+				"Invalid Backup Day",
+				fmt.Sprintf("The day must be `daily` or a day of the week, got: %s", data.DayOfWeek.ValueString()),
+			)
+		}
+	}
+
+	if !data.Method.IsNull() && !data.Method.IsUnknown() {
+		switch data.Method.ValueString() {
+		case "ftp", "scp", "sftp":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("method"),
+				"Invalid Backup Method",
+				fmt.Sprintf("The method must be one of `ftp`, `scp` or `sftp`, got: %s", data.Method.ValueString()),
+			)
+		}
+	}
+
+	if !data.Host.IsNull() && !data.Host.IsUnknown() && !validServerAddress(data.Host.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid Backup Host",
+			fmt.Sprintf("The host must be given as `address` or `address:port`, got: %s", data.Host.ValueString()),
+		)
+	}
+
+	if data.Enabled.ValueBool() && (data.Host.IsNull() || data.Method.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("enabled"),
+			"Missing Backup Target",
+			"The `host` and `method` must be configured to enable automated backups.",
+		)
+	}
+}
+
+func (r *BackupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BackupScheduleResourceModel
+	var config BackupScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters := r.parameters(data)
+	parameters.Password = config.Password.ValueStringPointer()
+
+	operation := ClientBackoff(func() (*api.BackupScheduleResponse, error) {
+		return r.client.ModifyBackupSchedule(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup schedule, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource backup schedule")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *BackupScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BackupScheduleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.BackupScheduleResponse, error) {
+		return r.client.ShowBackupSchedule()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup schedule, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *BackupScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BackupScheduleResourceModel
+	var config BackupScheduleResourceModel
+	var state BackupScheduleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parameters(data)
+
+	// The password is only sent again when its version changes.
+	if !data.PasswordVersion.Equal(state.PasswordVersion) {
+		parameters.Password = config.Password.ValueStringPointer()
+	}
+
+	operation := ClientBackoff(func() (*api.BackupScheduleResponse, error) {
+		return r.client.ModifyBackupSchedule(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update backup schedule, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BackupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	operation := ClientBackoff(func() (*api.BackupScheduleResponse, error) {
+		return r.client.ModifyBackupSchedule(api.BackupScheduleParameters{
+			Enable: bool2ptr(false),
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable backup schedule, got error: %s", err))
+		return
+	}
+}
+
+func (r *BackupScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data BackupScheduleResourceModel
+
+	operation := ClientBackoff(func() (*api.BackupScheduleResponse, error) {
+		return r.client.ShowBackupSchedule()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup schedule for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *BackupScheduleResource) parameters(data BackupScheduleResourceModel) api.BackupScheduleParameters {
+	return api.BackupScheduleParameters{
+		Enable:    data.Enabled.ValueBoolPointer(),
+		Hour:      data.Hour.ValueInt32Pointer(),
+		Minute:    data.Minute.ValueInt32Pointer(),
+		DayOfWeek: data.DayOfWeek.ValueString(),
+		Method:    data.Method.ValueString(),
+		Host:      data.Host.ValueString(),
+		Path:      data.Path.ValueString(),
+		Username:  data.Username.ValueString(),
+	}
+}
+
+func (r *BackupScheduleResource) fromResponse(data *BackupScheduleResourceModel, response *api.BackupScheduleResponse) {
+	data.Id = types.StringValue("backup_schedule")
+	data.Enabled = types.BoolValue(response.Enable)
+	data.Hour = types.Int32Value(response.Hour)
+	data.Minute = types.Int32Value(response.Minute)
+	data.DayOfWeek = types.StringValue(response.DayOfWeek)
+	data.Method = types.StringValue(response.Method)
+	data.Host = types.StringValue(response.Host)
+	data.Path = types.StringValue(response.Path)
+	data.Username = types.StringValue(response.Username)
+	data.Password = types.StringNull()
+}
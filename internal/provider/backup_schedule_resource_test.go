@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestBackupScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testBackupScheduleResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_backup_schedule.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("backup_schedule"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_backup_schedule.test",
+						tfjsonpath.New("method"),
+						knownvalue.StringExact("scp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_backup_schedule.test",
+						tfjsonpath.New("password"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_backup_schedule.test",
+				ImportState:             true,
+				ImportStateId:           "backup_schedule",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			{
+				Config: testBackupScheduleResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_backup_schedule.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testBackupScheduleResourceConfig = `
+resource "loadmaster_backup_schedule" "test" {
+  enabled = true
+  hour = 2
+  minute = 30
+  day_of_week = "daily"
+  method = "scp"
+  host = "192.0.2.50"
+  path = "/srv/backups"
+  username = "loadmaster"
+  password = "backuppassword"
+  password_version = 1
+}
+`
+
+const testBackupScheduleResourceConfigUpdate = `
+resource "loadmaster_backup_schedule" "test" {
+  enabled = false
+}
+`
@@ -4,17 +4,36 @@
 package provider
 
 import (
-	"strings"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/kreemer/loadmaster-go-client/api"
 )
 
 func ClientBackoff[T any](f func() (*T, error)) func() (*T, error) {
 
 	return func() (*T, error) {
+		generation := failoverGeneration.Load()
+
+		clientSwitch.RLock()
 		response, err := f()
+		clientSwitch.RUnlock()
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, backoff.RetryAfter(1)
+		}
 
-		if err != nil && strings.Contains(err.Error(), "EOF") {
+		// The unit may have failed over, the request is retried if a client
+		// was switched to the shared address since it was made.
+		var netErr net.Error
+		if errors.As(err, &netErr) && (followFailovers() || failoverGeneration.Load() != generation) {
 			return nil, backoff.RetryAfter(1)
 		}
 
@@ -25,3 +44,146 @@ func ClientBackoff[T any](f func() (*T, error)) func() (*T, error) {
 		return response, nil
 	}
 }
+
+// clientHosts records the host each client of the provider connects to, as
+// the client does not expose it.
+var clientHosts sync.Map
+
+// clientHost returns the host the client connects to, or an empty string if
+// the provider did not create the client.
+func clientHost(client *api.Client) string {
+	host, _ := clientHosts.Load(client)
+	value, _ := host.(string)
+
+	return value
+}
+
+// clientSwitch keeps the clients of the provider from being switched to the
+// shared address while a request is made.
+var clientSwitch sync.RWMutex
+
+// failoverGeneration counts the clients switched to the shared address.
+var failoverGeneration atomic.Int64
+
+// failoverFollowers holds a failoverFollower for every client which follows
+// the shared address of its high availability pair.
+var failoverFollowers sync.Map
+
+// failoverFollower switches a client to the shared address of its high
+// availability pair once the unit it connects to fails over.
+type failoverFollower struct {
+	mu        sync.Mutex
+	client    *api.Client
+	shared    string
+	newClient func(host string) *api.Client
+	show      func(client *api.Client) (*api.HAResponse, error)
+	followed  bool
+}
+
+// followFailover registers the client to be switched to the shared host once
+// its unit fails over during an apply.
+func followFailover(client *api.Client, shared string, newClient func(host string) *api.Client) {
+	failoverFollowers.Store(client, &failoverFollower{
+		client:    client,
+		shared:    shared,
+		newClient: newClient,
+		show:      (*api.Client).ShowHA,
+	})
+}
+
+// follow checks the unit of the client again and switches the client to the
+// shared address if the unit is no longer the active one and the shared
+// address answers as active unit. It returns whether the client was switched.
+// The status is read without ClientBackoff, which calls follow itself.
+func (f *failoverFollower) follow() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.followed {
+		return false
+	}
+
+	if response, err := f.show(f.client); err == nil && response.Status != "standby" {
+		return false
+	}
+
+	shared := f.newClient(f.shared)
+	response, err := f.show(shared)
+	if err != nil || response.Status != "active" {
+		return false
+	}
+
+	// The resources share the client, it is switched in place.
+	clientSwitch.Lock()
+	*f.client = *shared
+	clientSwitch.Unlock()
+
+	clientHosts.Store(f.client, f.shared)
+	failoverGeneration.Add(1)
+	f.followed = true
+
+	return true
+}
+
+// followFailovers lets every client which follows the shared address check
+// its unit after a connection error. It returns whether a client was switched.
+func followFailovers() bool {
+	followed := false
+
+	failoverFollowers.Range(func(_, value any) bool {
+		if value.(*failoverFollower).follow() {
+			followed = true
+		}
+		return true
+	})
+
+	return followed
+}
+
+// waitInterval is the time between two attempts to reach the LoadMaster while
+// waiting for it to come back.
+var waitInterval = 10 * time.Second
+
+// WaitForLoadMaster polls the LoadMaster until it answers requests again, e.g.
+// after a restore, and returns its firmware version. The progress function is
+// called after every failed attempt.
+func WaitForLoadMaster(ctx context.Context, client *api.Client, timeout time.Duration, progress func(string)) (string, error) {
+	return waitForLoadMaster(ctx, client, timeout, true, progress)
+}
+
+// WaitForLoadMasterRestart is like WaitForLoadMaster, but only returns after
+// the LoadMaster was unreachable at least once, so a LoadMaster which did not
+// start rebooting yet is not mistaken for one which is back.
+func WaitForLoadMasterRestart(ctx context.Context, client *api.Client, timeout time.Duration, progress func(string)) (string, error) {
+	return waitForLoadMaster(ctx, client, timeout, false, progress)
+}
+
+func waitForLoadMaster(ctx context.Context, client *api.Client, timeout time.Duration, down bool, progress func(string)) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	ticker := time.NewTicker(waitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("the LoadMaster was not reachable within %s", timeout)
+		case <-ticker.C:
+		}
+
+		response, err := client.GetParameter("version")
+		if err != nil {
+			down = true
+			progress(fmt.Sprintf("Waiting for the LoadMaster to become reachable (%s elapsed)", time.Since(start).Round(time.Second)))
+			continue
+		}
+
+		if down {
+			return response.Value, nil
+		}
+
+		progress(fmt.Sprintf("Waiting for the LoadMaster to restart (%s elapsed)", time.Since(start).Round(time.Second)))
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestClientBackoff(t *testing.T) {
+	tests := map[string]struct {
+		errs  []error
+		calls int
+		fail  bool
+	}{
+		"success": {
+			calls: 1,
+		},
+		"eof": {
+			errs:  []error{fmt.Errorf("post: %w", io.EOF)},
+			calls: 2,
+		},
+		"unexpected eof": {
+			errs:  []error{fmt.Errorf("read body: %w", io.ErrUnexpectedEOF)},
+			calls: 2,
+		},
+		"permanent": {
+			errs:  []error{errors.New("EOF is not in the chain")},
+			calls: 1,
+			fail:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			operation := ClientBackoff(func() (*string, error) {
+				calls++
+				if calls <= len(test.errs) {
+					return nil, test.errs[calls-1]
+				}
+				value := "ok"
+				return &value, nil
+			})
+
+			_, err := backoff.Retry(t.Context(), operation, backoff.WithBackOff(backoff.NewConstantBackOff(0)))
+			if (err != nil) != test.fail || calls != test.calls {
+				t.Errorf("expected %d calls and failure %t, got %d calls and error: %v", test.calls, test.fail, calls, err)
+			}
+		})
+	}
+}
+
+func TestClientBackoffFollowsFailover(t *testing.T) {
+	client := api.NewClientWithApiKey("10.0.0.1", "key")
+	failoverFollowers.Store(client, &failoverFollower{
+		client: client,
+		shared: "10.0.0.10",
+		newClient: func(host string) *api.Client {
+			return api.NewClientWithApiKey(host, "key")
+		},
+		show: func(c *api.Client) (*api.HAResponse, error) {
+			if c == client {
+				return nil, errors.New("unreachable")
+			}
+			return &api.HAResponse{Mode: "ha", Status: "active"}, nil
+		},
+	})
+	t.Cleanup(func() {
+		failoverFollowers.Delete(client)
+		clientHosts.Delete(client)
+	})
+
+	calls := 0
+	operation := ClientBackoff(func() (*string, error) {
+		calls++
+		if calls == 1 {
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		}
+		value := "ok"
+		return &value, nil
+	})
+
+	_, err := backoff.Retry(t.Context(), operation, backoff.WithBackOff(backoff.NewConstantBackOff(0)))
+	if err != nil || calls != 2 {
+		t.Errorf("expected the request to be retried after the failover, got %d calls and error: %v", calls, err)
+	}
+	if clientHost(client) != "10.0.0.10" {
+		t.Errorf("expected the client to connect to the shared address, got %q", clientHost(client))
+	}
+}
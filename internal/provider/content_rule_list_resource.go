@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ list.ListResource = &ContentRuleListResource[MatchContentRuleResourceModel]{}
+var _ list.ListResourceWithConfigure = &ContentRuleListResource[MatchContentRuleResourceModel]{}
+
+// ContentRuleListResource lists the content rules of one type. The LoadMaster
+// returns all rules at once, grouped by their type.
+type ContentRuleListResource[T any] struct {
+	client *api.Client
+
+	// name is the type name of the rule, e.g. `match_content_rule`.
+	name string
+	// description is the name of the rule used in descriptions.
+	description string
+	// rules returns the rules of the type from the response.
+	rules func(response *api.RuleResponse) []api.Rule
+	// model converts a rule to the model of its resource.
+	model func(rule api.Rule) T
+}
+
+type ContentRuleListResourceModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+func NewMatchContentRuleListResource() list.ListResource {
+	return &ContentRuleListResource[MatchContentRuleResourceModel]{
+		name:        "match_content_rule",
+		description: "match content rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.MatchContentRules },
+		model: func(rule api.Rule) MatchContentRuleResourceModel {
+			return MatchContentRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Header:       types.StringPointerValue(rule.Header),
+				Pattern:      types.StringValue(rule.Pattern),
+				MatchType:    types.StringValue(rule.MatchType),
+				IncHost:      types.BoolPointerValue(rule.IncHost),
+				NoCase:       types.BoolPointerValue(rule.CaseIndependent),
+				Negate:       types.BoolPointerValue(rule.Negate),
+				IncQuery:     types.BoolPointerValue(rule.IncHost),
+				SetOnMatch:   types.Int32PointerValue(rule.SetOnMatch),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+				MustFail:     types.BoolPointerValue(rule.MustFail),
+			}
+		},
+	}
+}
+
+func NewAddHeaderRuleListResource() list.ListResource {
+	return &ContentRuleListResource[AddHeaderRuleResourceModel]{
+		name:        "add_header_rule",
+		description: "add header rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.AddHeaderRules },
+		model: func(rule api.Rule) AddHeaderRuleResourceModel {
+			return AddHeaderRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Header:       types.StringPointerValue(rule.Header),
+				Replacement:  types.StringValue(rule.Replacement),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+			}
+		},
+	}
+}
+
+func NewDeleteHeaderRuleListResource() list.ListResource {
+	return &ContentRuleListResource[DeleteHeaderRuleResourceModel]{
+		name:        "delete_header_rule",
+		description: "delete header rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.DeleteHeaderRules },
+		model: func(rule api.Rule) DeleteHeaderRuleResourceModel {
+			return DeleteHeaderRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Header:       types.StringValue(rule.Pattern),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+			}
+		},
+	}
+}
+
+func NewReplaceHeaderRuleListResource() list.ListResource {
+	return &ContentRuleListResource[ReplaceHeaderRuleResourceModel]{
+		name:        "replace_header_rule",
+		description: "replace header rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.ReplaceHeaderRules },
+		model: func(rule api.Rule) ReplaceHeaderRuleResourceModel {
+			return ReplaceHeaderRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Header:       types.StringPointerValue(rule.Header),
+				Pattern:      types.StringValue(rule.Pattern),
+				Replacement:  types.StringValue(rule.Replacement),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+			}
+		},
+	}
+}
+
+func NewModifyUrlRuleListResource() list.ListResource {
+	return &ContentRuleListResource[ModifyUrlRuleResourceModel]{
+		name:        "modify_url_rule",
+		description: "modify URL rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.ModifyURLRules },
+		model: func(rule api.Rule) ModifyUrlRuleResourceModel {
+			return ModifyUrlRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Pattern:      types.StringValue(rule.Pattern),
+				Replacement:  types.StringValue(rule.Replacement),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+			}
+		},
+	}
+}
+
+func NewReplaceBodyRuleListResource() list.ListResource {
+	return &ContentRuleListResource[ReplaceBodyRuleResourceModel]{
+		name:        "replace_body_rule",
+		description: "replace body rules",
+		rules:       func(response *api.RuleResponse) []api.Rule { return response.ReplaceBodyRules },
+		model: func(rule api.Rule) ReplaceBodyRuleResourceModel {
+			return ReplaceBodyRuleResourceModel{
+				Id:           types.StringValue(rule.Name),
+				Pattern:      types.StringValue(rule.Pattern),
+				Replacement:  types.StringValue(rule.Replacement),
+				NoCase:       types.BoolPointerValue(rule.CaseIndependent),
+				OnlyOnFlag:   types.Int32PointerValue(rule.OnlyOnFlag),
+				OnlyOnNoFlag: types.Int32PointerValue(rule.OnlyOnNoFlag),
+			}
+		},
+	}
+}
+
+func (r *ContentRuleListResource[T]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name
+}
+
+func (r *ContentRuleListResource[T]) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the %s of the LoadMaster.", r.description),
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the name of the rule must match.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ContentRuleListResource[T]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContentRuleListResource[T]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ContentRuleListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	name, d := listFilterRegexp(data.Name, "name")
+	diags.Append(d...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.RuleResponse, error) {
+		return r.client.ListRules()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %s, got error: %s", r.description, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, rule := range r.rules(response) {
+			if !name.MatchString(rule.Name) {
+				continue
+			}
+
+			if listLimitReached(req, count) {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = rule.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, IdIdentityModel{Id: types.StringValue(rule.Name)})...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, r.model(rule))...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// createFault lets tests inject a failure into a named step of a create. The
+// provider hands it to its resources, it is nil outside of tests.
+type createFault func(step string) error
+
+// check returns the injected failure of the step, if any.
+func (f createFault) check(step string) error {
+	if f == nil {
+		return nil
+	}
+
+	return f(step)
+}
+
+// createStep wraps the operation of a named step of a create, so that tests
+// can make the step fail after the previous steps created their objects.
+func createStep[T any](fault createFault, step string, operation func() (*T, error)) func() (*T, error) {
+	return func() (*T, error) {
+		if err := fault.check(step); err != nil {
+			return nil, backoff.Permanent(err)
+		}
+
+		return operation()
+	}
+}
+
+// createRollback collects how to remove the objects created by the steps of a
+// create. When a later step fails, they are removed again so that no objects
+// remain on the LoadMaster that are missing from the state.
+type createRollback struct {
+	steps []rollbackStep
+}
+
+type rollbackStep struct {
+	description string
+	undo        func(ctx context.Context) error
+}
+
+// add registers how to remove an object that was created. The description
+// names the object in diagnostics.
+func (r *createRollback) add(description string, undo func(ctx context.Context) error) {
+	r.steps = append(r.steps, rollbackStep{description: description, undo: undo})
+}
+
+// run removes the created objects in reverse order after a failed step. It
+// returns false if an object could not be removed, the caller then saves the
+// partial state so that Terraform tracks the object and replaces it.
+func (r *createRollback) run(ctx context.Context, diags *diag.Diagnostics) bool {
+	complete := true
+
+	for i := len(r.steps) - 1; i >= 0; i-- {
+		step := r.steps[i]
+
+		if err := step.undo(ctx); err != nil {
+			diags.AddError(
+				"Rollback Failed",
+				fmt.Sprintf("Unable to remove the %s after the create failed, got error: %s. "+
+					"It is kept in the state and replaced on the next apply.", step.description, err),
+			)
+			complete = false
+			continue
+		}
+
+		tflog.Debug(ctx, "Rolled back a failed create", map[string]any{"object": step.description})
+	}
+
+	return complete
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestCreateRollback(t *testing.T) {
+	var undone []string
+	undo := func(name string, err error) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			undone = append(undone, name)
+			return err
+		}
+	}
+
+	var rollback createRollback
+	rollback.add("virtual service", undo("virtual service", nil))
+	rollback.add("real server", undo("real server", nil))
+
+	var diags diag.Diagnostics
+	if !rollback.run(t.Context(), &diags) || diags.HasError() {
+		t.Errorf("expected a complete rollback, got: %v", diags)
+	}
+	if !slices.Equal(undone, []string{"real server", "virtual service"}) {
+		t.Errorf("expected the objects to be removed in reverse order, got: %v", undone)
+	}
+
+	undone = nil
+	rollback = createRollback{}
+	rollback.add("virtual service", undo("virtual service", nil))
+	rollback.add("real server", undo("real server", errors.New("timeout")))
+
+	diags = nil
+	if rollback.run(t.Context(), &diags) || diags.ErrorsCount() != 1 {
+		t.Errorf("expected an incomplete rollback with one error, got: %v", diags)
+	}
+	if !slices.Equal(undone, []string{"real server", "virtual service"}) {
+		t.Errorf("expected the rollback to continue after a failure, got: %v", undone)
+	}
+}
+
+func TestCreateStep(t *testing.T) {
+	calls := 0
+	operation := func() (*string, error) {
+		calls++
+		value := "created"
+		return &value, nil
+	}
+
+	fault := func(step string) error {
+		if step == "modify" {
+			return errors.New("injected failure")
+		}
+		return nil
+	}
+
+	if _, err := backoff.Retry(t.Context(), createStep(fault, "add", operation)); err != nil || calls != 1 {
+		t.Errorf("expected the add step to run, got error: %v", err)
+	}
+	if _, err := backoff.Retry(t.Context(), createStep(fault, "modify", operation)); err == nil || calls != 1 {
+		t.Errorf("expected the modify step to fail without running")
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &DefaultGatewayResource{}
+var _ resource.ResourceWithImportState = &DefaultGatewayResource{}
+var _ resource.ResourceWithValidateConfig = &DefaultGatewayResource{}
+var _ resource.ResourceWithIdentity = &DefaultGatewayResource{}
+
+func NewDefaultGatewayResource() resource.Resource {
+	return &DefaultGatewayResource{}
+}
+
+type DefaultGatewayResource struct {
+	client *api.Client
+}
+
+type DefaultGatewayResourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Ipv4 types.String `tfsdk:"ipv4"`
+	Ipv6 types.String `tfsdk:"ipv6"`
+}
+
+func (r *DefaultGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_gateway"
+}
+
+func (r *DefaultGatewayResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the default gateway, always `default_gateway`.")
+}
+
+func (r *DefaultGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the default gateways of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource only removes it from the state, the default gateways are kept so the connection to the LoadMaster is not lost.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `default_gateway`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv4": schema.StringAttribute{
+				MarkdownDescription: "The IPv4 default gateway.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ipv6": schema.StringAttribute{
+				MarkdownDescription: "The IPv6 default gateway.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DefaultGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DefaultGatewayResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DefaultGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Ipv4.IsNull() && !data.Ipv4.IsUnknown() {
+		if ip := net.ParseIP(data.Ipv4.ValueString()); ip == nil || ip.To4() == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ipv4"),
+				"Invalid Default Gateway",
+				fmt.Sprintf("The gateway must be an IPv4 address, got: %s", data.Ipv4.ValueString()),
+			)
+		}
+	}
+
+	if !data.Ipv6.IsNull() && !data.Ipv6.IsUnknown() {
+		if ip := net.ParseIP(data.Ipv6.ValueString()); ip == nil || ip.To4() != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ipv6"),
+				"Invalid Default Gateway",
+				fmt.Sprintf("The gateway must be an IPv6 address, got: %s", data.Ipv6.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *DefaultGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DefaultGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.DefaultGatewayResponse, error) {
+		return r.client.ModifyDefaultGateway(api.DefaultGatewayParameters{
+			IPv4: data.Ipv4.ValueString(),
+			IPv6: data.Ipv6.ValueString(),
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create default gateway, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource default gateway")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *DefaultGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DefaultGatewayResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.DefaultGatewayResponse, error) {
+		return r.client.ShowDefaultGateway()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default gateway, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *DefaultGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DefaultGatewayResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.DefaultGatewayResponse, error) {
+		return r.client.ModifyDefaultGateway(api.DefaultGatewayParameters{
+			IPv4: data.Ipv4.ValueString(),
+			IPv6: data.Ipv6.ValueString(),
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update default gateway, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed default gateway from state, the gateways on the LoadMaster are kept")
+}
+
+func (r *DefaultGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data DefaultGatewayResourceModel
+
+	operation := ClientBackoff(func() (*api.DefaultGatewayResponse, error) {
+		return r.client.ShowDefaultGateway()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read default gateway for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *DefaultGatewayResource) fromResponse(data *DefaultGatewayResourceModel, response *api.DefaultGatewayResponse) {
+	data.Id = types.StringValue("default_gateway")
+	data.Ipv4 = types.StringValue(response.IPv4)
+	data.Ipv6 = types.StringValue(response.IPv6)
+}
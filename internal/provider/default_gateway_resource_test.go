@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestDefaultGatewayResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testDefaultGatewayResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_default_gateway.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("default_gateway"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_default_gateway.test",
+						tfjsonpath.New("ipv4"),
+						knownvalue.StringExact("10.0.0.1"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_default_gateway.test",
+				ImportState:       true,
+				ImportStateId:     "default_gateway",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testDefaultGatewayResourceConfig = `
+resource "loadmaster_default_gateway" "test" {
+  ipv4 = "10.0.0.1"
+}
+`
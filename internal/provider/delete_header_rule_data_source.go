@@ -52,11 +52,11 @@ func (d *DeleteHeaderRuleDataSource) Schema(ctx context.Context, req datasource.
 				Computed:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Computed:            true,
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Computed:            true,
 			},
 		},
@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &DeleteHeaderRuleResource{}
 var _ resource.ResourceWithImportState = &DeleteHeaderRuleResource{}
+var _ resource.ResourceWithIdentity = &DeleteHeaderRuleResource{}
 
 func NewDeleteHeaderRuleResource() resource.Resource {
 	return &DeleteHeaderRuleResource{}
@@ -40,6 +41,10 @@ func (r *DeleteHeaderRuleResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_delete_header_rule"
 }
 
+func (r *DeleteHeaderRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *DeleteHeaderRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `DeleteHeaderRule`.",
@@ -59,14 +64,16 @@ func (r *DeleteHeaderRuleResource) Schema(ctx context.Context, req resource.Sche
 				Default:             stringdefault.StaticString(""),
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 		},
 	}
@@ -126,6 +133,7 @@ func (r *DeleteHeaderRuleResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created a resource delete header rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *DeleteHeaderRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -160,6 +168,7 @@ func (r *DeleteHeaderRuleResource) Read(ctx context.Context, req resource.ReadRe
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *DeleteHeaderRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -221,8 +230,10 @@ func (r *DeleteHeaderRuleResource) Delete(ctx context.Context, req resource.Dele
 func (r *DeleteHeaderRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data DeleteHeaderRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -243,4 +254,5 @@ func (r *DeleteHeaderRuleResource) ImportState(ctx context.Context, req resource
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
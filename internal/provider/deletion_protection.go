@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionSchema is the `deletion_protection` attribute of resources
+// that take down traffic when they are destroyed. The value only lives in the
+// state, the LoadMaster does not know it.
+func deletionProtectionSchema(noun string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Whether Terraform refuses to destroy or replace the %s. "+
+			"It must be set to `false` in a separate apply before the %s can be destroyed or replaced. Defaults to `false`.", noun, noun),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection fails the plan if it destroys or replaces a resource
+// whose prior state has deletion protection enabled. Disabling the protection
+// in the same plan does not help, it must be applied first.
+func checkDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, noun string) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(deletionProtectionError(noun)...)
+		return
+	}
+
+	if len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	attributes := make([]string, len(resp.RequiresReplace))
+	for i, p := range resp.RequiresReplace {
+		attributes[i] = "`" + p.String() + "`"
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		resp.RequiresReplace[0],
+		"Deletion Protection Enabled",
+		fmt.Sprintf("Changing %s replaces the %s, which has `deletion_protection` enabled. "+
+			"Set `deletion_protection = false` and apply before replacing it.", strings.Join(attributes, ", "), noun),
+	)
+}
+
+// deletionProtectionError is the error of Delete when the state has deletion
+// protection enabled, e.g. because the plan check was bypassed.
+func deletionProtectionError(noun string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddAttributeError(
+		path.Root("deletion_protection"),
+		"Deletion Protection Enabled",
+		fmt.Sprintf("The %s has `deletion_protection` enabled and cannot be destroyed. "+
+			"Set `deletion_protection = false` and apply before destroying it.", noun),
+	)
+
+	return diags
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &EmailNotificationResource{}
+var _ resource.ResourceWithImportState = &EmailNotificationResource{}
+var _ resource.ResourceWithValidateConfig = &EmailNotificationResource{}
+var _ resource.ResourceWithIdentity = &EmailNotificationResource{}
+
+func NewEmailNotificationResource() resource.Resource {
+	return &EmailNotificationResource{}
+}
+
+type EmailNotificationResource struct {
+	client *api.Client
+}
+
+type EmailNotificationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Server             types.String `tfsdk:"server"`
+	Port               types.Int32  `tfsdk:"port"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	PasswordVersion    types.Int32  `tfsdk:"password_version"`
+	Security           types.String `tfsdk:"security"`
+	CriticalRecipients types.Set    `tfsdk:"critical_recipients"`
+	ErrorRecipients    types.Set    `tfsdk:"error_recipients"`
+	WarningRecipients  types.Set    `tfsdk:"warning_recipients"`
+	NoticeRecipients   types.Set    `tfsdk:"notice_recipients"`
+	InfoRecipients     types.Set    `tfsdk:"info_recipients"`
+}
+
+func (r *EmailNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_notification"
+}
+
+func (r *EmailNotificationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the email notification settings, always `email_notification`.")
+}
+
+func (r *EmailNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recipients := func(level string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The email addresses receiving notifications of level `%s`.", level),
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the email notification settings of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource removes all recipients.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `email_notification`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The hostname or address of the SMTP server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the SMTP server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username used to authenticate at the SMTP server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to authenticate at the SMTP server. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `password` to the LoadMaster again.",
+				Optional:            true,
+			},
+			"security": schema.StringAttribute{
+				MarkdownDescription: "The connection security of the SMTP server. One of `none`, `starttls` or `ssl`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"critical_recipients": recipients("critical"),
+			"error_recipients":    recipients("error"),
+			"warning_recipients":  recipients("warning"),
+			"notice_recipients":   recipients("notice"),
+			"info_recipients":     recipients("info"),
+		},
+	}
+}
+
+func (r *EmailNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EmailNotificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EmailNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, set := range map[string]types.Set{
+		"critical_recipients": data.CriticalRecipients,
+		"error_recipients":    data.ErrorRecipients,
+		"warning_recipients":  data.WarningRecipients,
+		"notice_recipients":   data.NoticeRecipients,
+		"info_recipients":     data.InfoRecipients,
+	} {
+		recipients, diags := stringSetValues(ctx, set)
+		resp.Diagnostics.Append(diags...)
+
+		for _, recipient := range recipients {
+			if _, err := mail.ParseAddress(recipient); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Recipient",
+					fmt.Sprintf("The recipients must be email addresses, got: %s", recipient),
+				)
+			}
+		}
+	}
+
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		if port := data.Port.ValueInt32(); port < 1 || port > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port"),
+				"Invalid SMTP Port",
+				fmt.Sprintf("The port must be between 1 and 65535, got: %d", port),
+			)
+		}
+	}
+
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
+		switch data.Security.ValueString() {
+		case "none", "starttls", "ssl":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("security"),
+				"Invalid SMTP Security",
+				fmt.Sprintf("The security must be one of `none`, `starttls` or `ssl`, got: %s", data.Security.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *EmailNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EmailNotificationResourceModel
+	var config EmailNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters, diags := r.parameters(ctx, data, config.Password)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.EmailNotificationResponse, error) {
+		return r.client.ModifyEmailNotification(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create email notification, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource email notification")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *EmailNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmailNotificationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.EmailNotificationResponse, error) {
+		return r.client.ShowEmailNotification()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read email notification, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *EmailNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EmailNotificationResourceModel
+	var config EmailNotificationResourceModel
+	var state EmailNotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The password is only sent again when its version changes.
+	password := types.StringNull()
+	if !data.PasswordVersion.Equal(state.PasswordVersion) {
+		password = config.Password
+	}
+
+	parameters, diags := r.parameters(ctx, data, password)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.EmailNotificationResponse, error) {
+		return r.client.ModifyEmailNotification(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update email notification, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	operation := ClientBackoff(func() (*api.EmailNotificationResponse, error) {
+		return r.client.ModifyEmailNotification(api.EmailNotificationParameters{
+			Critical: []string{},
+			Error:    []string{},
+			Warning:  []string{},
+			Notice:   []string{},
+			Info:     []string{},
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset email notification, got error: %s", err))
+		return
+	}
+}
+
+func (r *EmailNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data EmailNotificationResourceModel
+
+	operation := ClientBackoff(func() (*api.EmailNotificationResponse, error) {
+		return r.client.ShowEmailNotification()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read email notification for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *EmailNotificationResource) parameters(ctx context.Context, data EmailNotificationResourceModel, password types.String) (api.EmailNotificationParameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	recipients := func(set types.Set) []string {
+		values, d := stringSetValues(ctx, set)
+		diags.Append(d...)
+
+		return values
+	}
+
+	return api.EmailNotificationParameters{
+		Server:   data.Server.ValueString(),
+		Port:     data.Port.ValueInt32Pointer(),
+		Username: data.Username.ValueString(),
+		Password: password.ValueStringPointer(),
+		Security: data.Security.ValueString(),
+		Critical: recipients(data.CriticalRecipients),
+		Error:    recipients(data.ErrorRecipients),
+		Warning:  recipients(data.WarningRecipients),
+		Notice:   recipients(data.NoticeRecipients),
+		Info:     recipients(data.InfoRecipients),
+	}, diags
+}
+
+func (r *EmailNotificationResource) fromResponse(ctx context.Context, data *EmailNotificationResourceModel, response *api.EmailNotificationResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	recipients := func(values []string) types.Set {
+		set, d := stringSetValue(ctx, values)
+		diags.Append(d...)
+
+		return set
+	}
+
+	data.Id = types.StringValue("email_notification")
+	data.Server = types.StringValue(response.Server)
+	data.Port = types.Int32Value(response.Port)
+	data.Username = types.StringValue(response.Username)
+	data.Password = types.StringNull()
+	data.Security = types.StringValue(response.Security)
+	data.CriticalRecipients = recipients(response.Critical)
+	data.ErrorRecipients = recipients(response.Error)
+	data.WarningRecipients = recipients(response.Warning)
+	data.NoticeRecipients = recipients(response.Notice)
+	data.InfoRecipients = recipients(response.Info)
+
+	return diags
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestEmailNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testEmailNotificationResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_email_notification.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("email_notification"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_email_notification.test",
+						tfjsonpath.New("critical_recipients"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("noc@example.com"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_email_notification.test",
+						tfjsonpath.New("password"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_email_notification.test",
+				ImportState:             true,
+				ImportStateId:           "email_notification",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			{
+				Config: testEmailNotificationResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_email_notification.test",
+						tfjsonpath.New("security"),
+						knownvalue.StringExact("ssl"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_email_notification.test",
+						tfjsonpath.New("error_recipients"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testEmailNotificationResourceConfig = `
+resource "loadmaster_email_notification" "test" {
+  server = "smtp.example.com"
+  port = 587
+  username = "loadmaster"
+  password = "smtppassword"
+  password_version = 1
+  security = "starttls"
+  critical_recipients = ["noc@example.com"]
+}
+`
+
+const testEmailNotificationResourceConfigUpdate = `
+resource "loadmaster_email_notification" "test" {
+  server = "smtp.example.com"
+  port = 465
+  username = "loadmaster"
+  password = "smtppassword"
+  password_version = 1
+  security = "ssl"
+  critical_recipients = ["noc@example.com"]
+  error_recipients = ["noc@example.com", "oncall@example.com"]
+}
+`
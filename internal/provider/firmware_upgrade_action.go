@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ action.Action = &FirmwareUpgradeAction{}
+var _ action.ActionWithConfigure = &FirmwareUpgradeAction{}
+var _ action.ActionWithValidateConfig = &FirmwareUpgradeAction{}
+
+func NewFirmwareUpgradeAction() action.Action {
+	return &FirmwareUpgradeAction{}
+}
+
+type FirmwareUpgradeAction struct {
+	client *api.Client
+}
+
+type FirmwareUpgradeActionModel struct {
+	File           types.String `tfsdk:"file"`
+	Checksum       types.String `tfsdk:"checksum"`
+	AllowDowngrade types.Bool   `tfsdk:"allow_downgrade"`
+	Timeout        types.Int32  `tfsdk:"timeout"`
+}
+
+// firmwarePatch holds the metadata of a firmware patch file.
+type firmwarePatch struct {
+	Version   string
+	Signature string
+}
+
+func (a *FirmwareUpgradeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firmware_upgrade"
+}
+
+func (a *FirmwareUpgradeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This action installs a firmware patch on the LoadMaster. " +
+			"The checksum and the version and signature metadata of the patch are validated before it is uploaded. " +
+			"After the patch is installed, the LoadMaster is rebooted and the action waits until it runs the firmware version of the patch. " +
+			"If the LoadMaster already runs that version, nothing is done.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path of the local firmware patch file.",
+				Required:            true,
+			},
+			"checksum": schema.StringAttribute{
+				MarkdownDescription: "The expected SHA-256 checksum of the patch file, as published with the firmware.",
+				Required:            true,
+			},
+			"allow_downgrade": schema.BoolAttribute{
+				MarkdownDescription: "Install the patch even if its firmware version is older than the one running on the LoadMaster. Defaults to `false`.",
+				Optional:            true,
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "The time in seconds to wait for the LoadMaster to come back after the reboot. Defaults to `1800`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *FirmwareUpgradeAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *FirmwareUpgradeAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data FirmwareUpgradeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Checksum.IsUnknown() {
+		if checksum, err := hex.DecodeString(data.Checksum.ValueString()); err != nil || len(checksum) != sha256.Size {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("checksum"),
+				"Invalid Checksum",
+				fmt.Sprintf("The checksum must be a hex encoded SHA-256 checksum, got: %s", data.Checksum.ValueString()),
+			)
+		}
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() && data.Timeout.ValueInt32() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("The timeout must be positive, got: %d", data.Timeout.ValueInt32()),
+		)
+	}
+}
+
+func (a *FirmwareUpgradeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data FirmwareUpgradeActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Validating firmware patch %s", data.File.ValueString())})
+
+	content, err := os.ReadFile(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "Error reading firmware patch", err.Error())
+		return
+	}
+
+	patch, err := inspectFirmwarePatch(content, data.Checksum.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Invalid firmware patch",
+			fmt.Sprintf("The file %s is not a valid firmware patch: %s", data.File.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ParameterResponse, error) {
+		return a.client.GetParameter("version")
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read version of the LoadMaster, got error: %s", err))
+		return
+	}
+
+	switch compareFirmwareVersions(patch.Version, response.Value) {
+	case 0:
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("The LoadMaster already runs firmware %s", response.Value)})
+		return
+	case -1:
+		if !data.AllowDowngrade.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allow_downgrade"),
+				"Firmware Downgrade",
+				fmt.Sprintf("The patch contains firmware %s, which is older than %s running on the LoadMaster. Set `allow_downgrade` to install it anyway.", patch.Version, response.Value),
+			)
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Installing firmware %s (%d bytes), the LoadMaster runs %s", patch.Version, len(content), response.Value),
+	})
+
+	// The patch is not retried, a failed upload must not be installed twice.
+	if _, err := a.client.InstallPatch(content); err != nil {
+		resp.Diagnostics.AddError(
+			"Error installing firmware patch",
+			fmt.Sprintf("Could not install firmware patch %s: %s", data.File.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Firmware patch installed, rebooting the LoadMaster"})
+
+	if err := rebootLoadMaster(a.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error rebooting LoadMaster",
+			fmt.Sprintf("The firmware patch was installed, but the LoadMaster could not be rebooted: %s", err.Error()),
+		)
+		return
+	}
+
+	timeout := 1800 * time.Second
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt32()) * time.Second
+	}
+
+	version, err := WaitForLoadMasterRestart(ctx, a.client, timeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error installing firmware patch", fmt.Sprintf("The firmware patch was installed, but %s", err.Error()))
+		return
+	}
+
+	if compareFirmwareVersions(patch.Version, version) != 0 {
+		resp.Diagnostics.AddError(
+			"Error installing firmware patch",
+			fmt.Sprintf("The LoadMaster is reachable again, but runs firmware %s instead of %s.", version, patch.Version),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Installed firmware patch", map[string]any{"file": data.File.ValueString(), "version": version})
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("The LoadMaster runs firmware %s", version)})
+}
+
+// inspectFirmwarePatch validates the checksum of a firmware patch and reads
+// the version and signature from its metadata. The signature itself is
+// verified by the LoadMaster when the patch is installed.
+func inspectFirmwarePatch(content []byte, checksum string) (firmwarePatch, error) {
+	patch := firmwarePatch{}
+
+	sum := sha256.Sum256(content)
+	if actual := hex.EncodeToString(sum[:]); !strings.EqualFold(actual, checksum) {
+		return patch, fmt.Errorf("expected checksum %s, got %s", checksum, actual)
+	}
+
+	entries, err := readArchiveEntries(content, "version", "signature")
+	if err != nil {
+		return patch, err
+	}
+
+	patch.Version = entries["version"]
+	patch.Signature = entries["signature"]
+	if patch.Version == "" {
+		return patch, errors.New("the patch does not contain a firmware version")
+	}
+	if patch.Signature == "" {
+		return patch, errors.New("the patch is not signed")
+	}
+
+	return patch, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestFirmwareUpgradeActionInvalidChecksum(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "loadmaster_firmware_upgrade" "this" {
+  config {
+    file     = "patch"
+    checksum = "md5:1234"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Checksum"),
+			},
+		},
+	})
+}
+
+func TestInspectFirmwarePatch(t *testing.T) {
+	content := testBackupArchive(t, map[string]string{
+		"patch/version":   "7.2.60.0.22514\n",
+		"patch/signature": "c2lnbmF0dXJl\n",
+		"patch/image":     "image",
+	})
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	patch, err := inspectFirmwarePatch(content, checksum)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if patch.Version != "7.2.60.0.22514" {
+		t.Errorf("expected version 7.2.60.0.22514, got %s", patch.Version)
+	}
+
+	if _, err := inspectFirmwarePatch(content, hex.EncodeToString(make([]byte, sha256.Size))); err == nil {
+		t.Error("expected an error for a checksum mismatch")
+	}
+
+	unsigned := testBackupArchive(t, map[string]string{"patch/version": "7.2.60.0.22514\n"})
+	sum = sha256.Sum256(unsigned)
+	if _, err := inspectFirmwarePatch(unsigned, hex.EncodeToString(sum[:])); err == nil {
+		t.Error("expected an error for an unsigned patch")
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &GeoClusterResource{}
+var _ resource.ResourceWithImportState = &GeoClusterResource{}
+var _ resource.ResourceWithIdentity = &GeoClusterResource{}
+
+func NewGeoClusterResource() resource.Resource {
+	return &GeoClusterResource{}
+}
+
+type GeoClusterResource struct {
+	client *api.Client
+}
+
+type GeoClusterResourceModel struct {
+	Id        types.String  `tfsdk:"id"`
+	Address   types.String  `tfsdk:"address"`
+	Name      types.String  `tfsdk:"name"`
+	Type      types.String  `tfsdk:"type"`
+	Latitude  types.Float64 `tfsdk:"latitude"`
+	Longitude types.Float64 `tfsdk:"longitude"`
+}
+
+func (r *GeoClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_cluster"
+}
+
+func (r *GeoClusterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("address", "The address of the cluster.")
+}
+
+func (r *GeoClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a GEO cluster, which represents a site taking part in global server load balancing.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the cluster, same as `address`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the cluster.",
+				Required:            true,
+				Validators:          ipAddress(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the site.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the cluster. One of `default`, `remoteLM` or `localLM`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          oneOf("default", "remoteLM", "localLM"),
+			},
+			"latitude": schema.Float64Attribute{
+				MarkdownDescription: "The latitude of the site in decimal degrees.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"longitude": schema.Float64Attribute{
+				MarkdownDescription: "The longitude of the site in decimal degrees.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GeoClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeoClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GeoClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "address", data.Address)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.GeoClusterResponse, error) {
+		return r.client.AddGeoCluster(data.Address.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create geo cluster, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource geo cluster")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("address"), data.Address)...)
+}
+
+func (r *GeoClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GeoClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoClusterResponse, error) {
+		return r.client.ShowGeoCluster(data.Address.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isGeoNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo cluster, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("address"), data.Address)...)
+}
+
+func (r *GeoClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GeoClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoClusterResponse, error) {
+		return r.client.ModifyGeoCluster(data.Address.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update geo cluster, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GeoClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GeoClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteGeoCluster(data.Address.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete geo cluster, got error: %s", err))
+		return
+	}
+}
+
+func (r *GeoClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data GeoClusterResourceModel
+
+	address := importAttribute(ctx, req, "address", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.GeoClusterResponse, error) {
+		return r.client.ShowGeoCluster(address)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo cluster for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("address"), data.Address)...)
+}
+
+func (r *GeoClusterResource) parameters(data GeoClusterResourceModel) api.GeoClusterParameters {
+	return api.GeoClusterParameters{
+		Name:      data.Name.ValueString(),
+		Type:      data.Type.ValueString(),
+		Latitude:  data.Latitude.ValueFloat64Pointer(),
+		Longitude: data.Longitude.ValueFloat64Pointer(),
+	}
+}
+
+func (r *GeoClusterResource) fromResponse(data *GeoClusterResourceModel, response *api.GeoClusterResponse) {
+	data.Id = types.StringValue(response.Address)
+	data.Address = types.StringValue(response.Address)
+	data.Name = types.StringValue(response.Name)
+	data.Type = types.StringValue(response.Type)
+	data.Latitude = types.Float64Value(response.Latitude)
+	data.Longitude = types.Float64Value(response.Longitude)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGeoClusterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGeoClusterResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_cluster.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("192.0.2.2"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_cluster.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("geneva"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_cluster.test",
+						tfjsonpath.New("latitude"),
+						knownvalue.Float64Exact(46.2),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_geo_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGeoClusterResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_cluster.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("geneva-2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testGeoClusterResourceConfig = `
+resource "loadmaster_geo_cluster" "test" {
+  address = "192.0.2.2"
+  name = "geneva"
+  type = "default"
+  latitude = 46.2
+  longitude = 6.1
+}
+`
+
+const testGeoClusterResourceConfigUpdate = `
+resource "loadmaster_geo_cluster" "test" {
+  address = "192.0.2.2"
+  name = "geneva-2"
+  type = "default"
+  latitude = 46.2
+  longitude = 6.1
+}
+`
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var (
+	_ datasource.DataSource              = &GeoFilterFeedDataSource{}
+	_ datasource.DataSourceWithConfigure = &GeoFilterFeedDataSource{}
+)
+
+func NewGeoFilterFeedDataSource() datasource.DataSource {
+	return &GeoFilterFeedDataSource{}
+}
+
+type GeoFilterFeedDataSource struct {
+	client *api.Client
+}
+
+type GeoFilterFeedDataSourceModel struct {
+	GeoVersion          types.String `tfsdk:"geo_version"`
+	IpReputationVersion types.String `tfsdk:"ip_reputation_version"`
+	LastUpdate          types.String `tfsdk:"last_update"`
+}
+
+func (d *GeoFilterFeedDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_filter_feed"
+}
+
+func (d *GeoFilterFeedDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to retrieve the versions of the geo and IP reputation feeds installed on the LoadMaster.",
+
+		Attributes: map[string]schema.Attribute{
+			"geo_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the installed geo feed.",
+				Computed:            true,
+			},
+			"ip_reputation_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the installed IP reputation feed.",
+				Computed:            true,
+			},
+			"last_update": schema.StringAttribute{
+				MarkdownDescription: "The time of the last feed update.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GeoFilterFeedDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *GeoFilterFeedDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GeoFilterFeedDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterFeedResponse, error) {
+		return d.client.ShowGeoFilterFeed()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo filter feed, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.GeoVersion = types.StringValue(response.GeoVersion)
+	data.IpReputationVersion = types.StringValue(response.IPReputationVersion)
+	data.LastUpdate = types.StringValue(response.LastUpdate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &GeoFilterResource{}
+var _ resource.ResourceWithImportState = &GeoFilterResource{}
+var _ resource.ResourceWithValidateConfig = &GeoFilterResource{}
+var _ resource.ResourceWithIdentity = &GeoFilterResource{}
+
+func NewGeoFilterResource() resource.Resource {
+	return &GeoFilterResource{}
+}
+
+type GeoFilterResource struct {
+	client *api.Client
+}
+
+type GeoFilterResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Mode                 types.String `tfsdk:"mode"`
+	Countries            types.Set    `tfsdk:"countries"`
+	IpReputationBlocking types.Bool   `tfsdk:"ip_reputation_blocking"`
+	AutoUpdate           types.Bool   `tfsdk:"auto_update"`
+	AutoUpdateHour       types.Int32  `tfsdk:"auto_update_hour"`
+}
+
+func (r *GeoFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_filter"
+}
+
+func (r *GeoFilterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the geo filter, always `geo_filter`.")
+}
+
+func (r *GeoFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the global geo filtering and IP reputation settings of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource clears the country list and disables IP reputation blocking.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `geo_filter`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the `countries` are blocked or allowed, either `block` or `allow`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"countries": schema.SetAttribute{
+				MarkdownDescription: "ISO 3166-1 alpha-2 codes of the countries to block or allow.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"ip_reputation_blocking": schema.BoolAttribute{
+				MarkdownDescription: "Block clients with a bad IP reputation.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"auto_update": schema.BoolAttribute{
+				MarkdownDescription: "Update the geo and IP reputation feeds automatically.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"auto_update_hour": schema.Int32Attribute{
+				MarkdownDescription: "The hour of the day, between `0` and `23`, at which the feeds are updated automatically.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GeoFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeoFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateGeoFilter(ctx, data.Mode, data.Countries)...)
+
+	if !data.AutoUpdateHour.IsNull() && !data.AutoUpdateHour.IsUnknown() {
+		if hour := data.AutoUpdateHour.ValueInt32(); hour < 0 || hour > 23 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auto_update_hour"),
+				"Invalid Auto Update Hour",
+				fmt.Sprintf("The hour must be between 0 and 23, got: %d", hour),
+			)
+		}
+	}
+}
+
+func (r *GeoFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyGeoFilter(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create geo filter, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource geo filter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ShowGeoFilter()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo filter, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyGeoFilter(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update geo filter, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GeoFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyGeoFilter(api.GeoFilterParameters{
+			Countries:            []string{},
+			IPReputationBlocking: bool2ptr(false),
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset geo filter, got error: %s", err))
+		return
+	}
+}
+
+func (r *GeoFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data GeoFilterResourceModel
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ShowGeoFilter()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo filter for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoFilterResource) parameters(ctx context.Context, data GeoFilterResourceModel) (api.GeoFilterParameters, diag.Diagnostics) {
+	countries, diags := stringSetValues(ctx, data.Countries)
+
+	return api.GeoFilterParameters{
+		Mode:                 data.Mode.ValueString(),
+		Countries:            countries,
+		IPReputationBlocking: data.IpReputationBlocking.ValueBoolPointer(),
+		AutoUpdate:           data.AutoUpdate.ValueBoolPointer(),
+		AutoUpdateHour:       data.AutoUpdateHour.ValueInt32Pointer(),
+	}, diags
+}
+
+func (r *GeoFilterResource) fromResponse(ctx context.Context, data *GeoFilterResourceModel, response *api.GeoFilterResponse) diag.Diagnostics {
+	countries, diags := stringSetValue(ctx, response.Countries)
+
+	data.Id = types.StringValue("geo_filter")
+	data.Mode = types.StringValue(response.Mode)
+	data.Countries = countries
+	data.IpReputationBlocking = types.BoolPointerValue(response.IPReputationBlocking)
+	data.AutoUpdate = types.BoolPointerValue(response.AutoUpdate)
+	data.AutoUpdateHour = types.Int32Value(response.AutoUpdateHour)
+
+	return diags
+}
+
+func validateGeoFilter(ctx context.Context, mode types.String, set types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !mode.IsNull() && !mode.IsUnknown() && mode.ValueString() != "block" && mode.ValueString() != "allow" {
+		diags.AddAttributeError(
+			path.Root("mode"),
+			"Invalid Geo Filter Mode",
+			fmt.Sprintf("The mode must be either `block` or `allow`, got: %s", mode.ValueString()),
+		)
+	}
+
+	if set.IsNull() || set.IsUnknown() {
+		return diags
+	}
+
+	var countries []types.String
+	diags.Append(set.ElementsAs(ctx, &countries, true)...)
+
+	var invalid []string
+	for _, country := range countries {
+		if country.IsUnknown() || country.IsNull() {
+			continue
+		}
+
+		if !isISO3166CountryCode(country.ValueString()) {
+			invalid = append(invalid, country.ValueString())
+		}
+	}
+
+	if len(invalid) > 0 {
+		diags.AddAttributeError(
+			path.Root("countries"),
+			"Invalid Country Code",
+			fmt.Sprintf("The countries must be uppercase ISO 3166-1 alpha-2 codes, got: %s", strings.Join(invalid, ", ")),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGeoFilterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGeoFilterResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("geo_filter"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("mode"),
+						knownvalue.StringExact("block"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("countries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("KP"),
+							knownvalue.StringExact("RU"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("auto_update_hour"),
+						knownvalue.Int32Exact(3),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_geo_filter.test",
+				ImportState:       true,
+				ImportStateId:     "geo_filter",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGeoFilterResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("countries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("KP"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_filter.test",
+						tfjsonpath.New("ip_reputation_blocking"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateGeoFilter(t *testing.T) {
+	ctx := context.Background()
+
+	countries := func(codes ...string) types.Set {
+		elements := []attr.Value{}
+		for _, code := range codes {
+			elements = append(elements, types.StringValue(code))
+		}
+
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	tests := map[string]struct {
+		mode      types.String
+		countries types.Set
+		errors    int
+	}{
+		"valid":          {types.StringValue("block"), countries("DE", "CH"), 0},
+		"null":           {types.StringNull(), types.SetNull(types.StringType), 0},
+		"invalid mode":   {types.StringValue("deny"), countries("DE"), 1},
+		"lowercase code": {types.StringValue("allow"), countries("de"), 1},
+		"unknown code":   {types.StringValue("allow"), countries("XX", "UK"), 1},
+		"both invalid":   {types.StringValue("deny"), countries("XX"), 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diags := validateGeoFilter(ctx, test.mode, test.countries)
+
+			if diags.ErrorsCount() != test.errors {
+				t.Errorf("expected %d errors, got: %v", test.errors, diags)
+			}
+		})
+	}
+}
+
+const testGeoFilterResourceConfig = `
+resource "loadmaster_geo_filter" "test" {
+  mode = "block"
+  countries = ["KP", "RU"]
+  ip_reputation_blocking = true
+  auto_update = true
+  auto_update_hour = 3
+}
+`
+
+const testGeoFilterResourceConfigUpdate = `
+resource "loadmaster_geo_filter" "test" {
+  mode = "block"
+  countries = ["KP"]
+  ip_reputation_blocking = false
+  auto_update = true
+  auto_update_hour = 3
+}
+`
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &GeoFqdnAddressResource{}
+var _ resource.ResourceWithImportState = &GeoFqdnAddressResource{}
+var _ resource.ResourceWithIdentity = &GeoFqdnAddressResource{}
+
+func NewGeoFqdnAddressResource() resource.Resource {
+	return &GeoFqdnAddressResource{}
+}
+
+type GeoFqdnAddressResource struct {
+	client *api.Client
+}
+
+type GeoFqdnAddressResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Fqdn        types.String `tfsdk:"fqdn"`
+	Address     types.String `tfsdk:"address"`
+	Cluster     types.String `tfsdk:"cluster"`
+	Weight      types.Int32  `tfsdk:"weight"`
+	Checker     types.String `tfsdk:"checker"`
+	CheckerPort types.Int32  `tfsdk:"checker_port"`
+}
+
+type GeoFqdnAddressIdentityModel struct {
+	Fqdn    types.String `tfsdk:"fqdn"`
+	Address types.String `tfsdk:"address"`
+}
+
+func (r *GeoFqdnAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_fqdn_address"
+}
+
+func (r *GeoFqdnAddressResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"fqdn": identityschema.StringAttribute{
+				Description:       "The fully qualified domain name.",
+				RequiredForImport: true,
+			},
+			"address": identityschema.StringAttribute{
+				Description:       "The address of the FQDN.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *GeoFqdnAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an address returned for a GEO `FQDN`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the address in the format `fqdn/address`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified domain name the address belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The IP address returned for the FQDN.",
+				Required:            true,
+				Validators:          ipAddress(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster": schema.StringAttribute{
+				MarkdownDescription: "The IP address of the GEO cluster the address is mapped to.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          ipAddress(),
+			},
+			"weight": schema.Int32Attribute{
+				MarkdownDescription: "The weight of the address, used by weighted selection criteria.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"checker": schema.StringAttribute{
+				MarkdownDescription: "The health check of the address. One of `none`, `icmp`, `tcp` or `cluster`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          oneOf("none", "icmp", "tcp", "cluster"),
+			},
+			"checker_port": schema.Int32Attribute{
+				MarkdownDescription: "The port used by the `tcp` health check.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          portNumber(),
+			},
+		},
+	}
+}
+
+func (r *GeoFqdnAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeoFqdnAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GeoFqdnAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "fqdn", data.Fqdn)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.GeoFqdnAddressResponse, error) {
+		return r.client.AddGeoFqdnAddress(data.Fqdn.ValueString(), data.Address.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create geo fqdn address, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource geo fqdn address")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GeoFqdnAddressIdentityModel{Fqdn: data.Fqdn, Address: data.Address})...)
+}
+
+func (r *GeoFqdnAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GeoFqdnAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFqdnAddressResponse, error) {
+		return r.client.ShowGeoFqdnAddress(data.Fqdn.ValueString(), data.Address.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isGeoNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo fqdn address, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GeoFqdnAddressIdentityModel{Fqdn: data.Fqdn, Address: data.Address})...)
+}
+
+func (r *GeoFqdnAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GeoFqdnAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFqdnAddressResponse, error) {
+		return r.client.ModifyGeoFqdnAddress(data.Fqdn.ValueString(), data.Address.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update geo fqdn address, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GeoFqdnAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GeoFqdnAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteGeoFqdnAddress(data.Fqdn.ValueString(), data.Address.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete geo fqdn address, got error: %s", err))
+		return
+	}
+}
+
+func (r *GeoFqdnAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data GeoFqdnAddressResourceModel
+
+	id := req.ID
+	if identity, ok := importIdentity[GeoFqdnAddressIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		id = identity.Fqdn.ValueString() + "/" + identity.Address.ValueString()
+	}
+
+	id_list := strings.Split(id, "/")
+
+	if len(id_list) != 2 {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s, expected `fqdn/address`", id))
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFqdnAddressResponse, error) {
+		return r.client.ShowGeoFqdnAddress(id_list[0], id_list[1])
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo fqdn address for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Fqdn = types.StringValue(id_list[0])
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, GeoFqdnAddressIdentityModel{Fqdn: data.Fqdn, Address: data.Address})...)
+}
+
+func (r *GeoFqdnAddressResource) parameters(data GeoFqdnAddressResourceModel) api.GeoFqdnAddressParameters {
+	return api.GeoFqdnAddressParameters{
+		Cluster:     data.Cluster.ValueString(),
+		Weight:      data.Weight.ValueInt32Pointer(),
+		Checker:     data.Checker.ValueString(),
+		CheckerPort: data.CheckerPort.ValueInt32Pointer(),
+	}
+}
+
+func (r *GeoFqdnAddressResource) fromResponse(data *GeoFqdnAddressResourceModel, response *api.GeoFqdnAddressResponse) {
+	data.Id = types.StringValue(data.Fqdn.ValueString() + "/" + response.Address)
+	data.Address = types.StringValue(response.Address)
+	data.Cluster = types.StringValue(response.Cluster)
+	data.Weight = types.Int32Value(response.Weight)
+	data.Checker = types.StringValue(response.Checker)
+	data.CheckerPort = types.Int32Value(response.CheckerPort)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGeoFqdnAddressResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGeoFqdnAddressResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn_address.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("app.example.com./192.0.2.10"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn_address.test",
+						tfjsonpath.New("cluster"),
+						knownvalue.StringExact("192.0.2.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn_address.test",
+						tfjsonpath.New("weight"),
+						knownvalue.Int32Exact(100),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_geo_fqdn_address.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGeoFqdnAddressResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn_address.test",
+						tfjsonpath.New("checker"),
+						knownvalue.StringExact("tcp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn_address.test",
+						tfjsonpath.New("checker_port"),
+						knownvalue.Int32Exact(443),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testGeoFqdnAddressResourceConfig = `
+resource "loadmaster_geo_cluster" "test" {
+  address = "192.0.2.1"
+  name = "zurich"
+}
+
+resource "loadmaster_geo_fqdn" "test" {
+  fqdn = "app.example.com."
+}
+
+resource "loadmaster_geo_fqdn_address" "test" {
+  fqdn = loadmaster_geo_fqdn.test.fqdn
+  address = "192.0.2.10"
+  cluster = loadmaster_geo_cluster.test.address
+  weight = 100
+}
+`
+
+const testGeoFqdnAddressResourceConfigUpdate = `
+resource "loadmaster_geo_cluster" "test" {
+  address = "192.0.2.1"
+  name = "zurich"
+}
+
+resource "loadmaster_geo_fqdn" "test" {
+  fqdn = "app.example.com."
+}
+
+resource "loadmaster_geo_fqdn_address" "test" {
+  fqdn = loadmaster_geo_fqdn.test.fqdn
+  address = "192.0.2.10"
+  cluster = loadmaster_geo_cluster.test.address
+  weight = 100
+  checker = "tcp"
+  checker_port = 443
+}
+`
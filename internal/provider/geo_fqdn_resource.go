@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &GeoFqdnResource{}
+var _ resource.ResourceWithImportState = &GeoFqdnResource{}
+var _ resource.ResourceWithModifyPlan = &GeoFqdnResource{}
+var _ resource.ResourceWithIdentity = &GeoFqdnResource{}
+
+func NewGeoFqdnResource() resource.Resource {
+	return &GeoFqdnResource{}
+}
+
+type GeoFqdnResource struct {
+	client *api.Client
+}
+
+type GeoFqdnResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Fqdn              types.String `tfsdk:"fqdn"`
+	SelectionCriteria types.String `tfsdk:"selection_criteria"`
+	Failover          types.Bool   `tfsdk:"failover"`
+	SiteRecoveryMode  types.String `tfsdk:"site_recovery_mode"`
+}
+
+func (r *GeoFqdnResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_fqdn"
+}
+
+func (r *GeoFqdnResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("fqdn", "The fully qualified domain name.")
+}
+
+func (r *GeoFqdnResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a fully qualified domain name served by the GEO module of the LoadMaster.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the FQDN, same as `fqdn`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified domain name, e.g. `www.example.com.`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selection_criteria": schema.StringAttribute{
+				MarkdownDescription: "The selection criteria used to pick an address. One of `rr` (round robin), `wrr` (weighted round robin), `fw` (fixed weighting), `rsr` (real server load), `prx` (proximity), `lb` (location based) or `all` (all available).",
+				Optional:            true,
+				Computed:            true,
+			},
+			"failover": schema.BoolAttribute{
+				MarkdownDescription: "Whether failover is enabled for location based selection.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"site_recovery_mode": schema.StringAttribute{
+				MarkdownDescription: "How a failed site is brought back into service, either `auto` or `manual`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GeoFqdnResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeoFqdnResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only new resources are checked, existing ones were accepted by the LoadMaster.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(requireLicensedFeature(ctx, r.client, "geo", path.Root("fqdn"))...)
+}
+
+func (r *GeoFqdnResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GeoFqdnResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "fqdn", data.Fqdn)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.GeoFqdnResponse, error) {
+		return r.client.AddGeoFqdn(data.Fqdn.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create geo fqdn, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource geo fqdn")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("fqdn"), data.Fqdn)...)
+}
+
+func (r *GeoFqdnResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GeoFqdnResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFqdnResponse, error) {
+		return r.client.ShowGeoFqdn(data.Fqdn.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isGeoNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo fqdn, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("fqdn"), data.Fqdn)...)
+}
+
+func (r *GeoFqdnResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GeoFqdnResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFqdnResponse, error) {
+		return r.client.ModifyGeoFqdn(data.Fqdn.ValueString(), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update geo fqdn, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GeoFqdnResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GeoFqdnResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteGeoFqdn(data.Fqdn.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete geo fqdn, got error: %s", err))
+		return
+	}
+}
+
+func (r *GeoFqdnResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data GeoFqdnResourceModel
+
+	fqdn := importAttribute(ctx, req, "fqdn", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.GeoFqdnResponse, error) {
+		return r.client.ShowGeoFqdn(fqdn)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo fqdn for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Fqdn = types.StringValue(fqdn)
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("fqdn"), data.Fqdn)...)
+}
+
+func (r *GeoFqdnResource) parameters(data GeoFqdnResourceModel) api.GeoFqdnParameters {
+	return api.GeoFqdnParameters{
+		SelectionCriteria: data.SelectionCriteria.ValueString(),
+		Failover:          data.Failover.ValueBoolPointer(),
+		SiteRecoveryMode:  data.SiteRecoveryMode.ValueString(),
+	}
+}
+
+func (r *GeoFqdnResource) fromResponse(data *GeoFqdnResourceModel, response *api.GeoFqdnResponse) {
+	data.Id = types.StringValue(data.Fqdn.ValueString())
+	data.SelectionCriteria = types.StringValue(response.SelectionCriteria)
+	data.Failover = types.BoolPointerValue(response.Failover)
+	data.SiteRecoveryMode = types.StringValue(response.SiteRecoveryMode)
+}
+
+// isGeoNotFoundError reports whether the LoadMaster rejected a GEO request
+// because the referenced FQDN, address or cluster does not exist.
+func isGeoNotFoundError(err error) bool {
+	serr, ok := err.(*api.LoadMasterError)
+	if !ok {
+		return false
+	}
+
+	switch serr.Message {
+	case "Unknown FQDN", "Unknown IP address", "Unknown cluster":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGeoFqdnResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGeoFqdnResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("www.example.com."),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn.test",
+						tfjsonpath.New("selection_criteria"),
+						knownvalue.StringExact("rr"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_geo_fqdn.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGeoFqdnResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn.test",
+						tfjsonpath.New("selection_criteria"),
+						knownvalue.StringExact("lb"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn.test",
+						tfjsonpath.New("failover"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_fqdn.test",
+						tfjsonpath.New("site_recovery_mode"),
+						knownvalue.StringExact("manual"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testGeoFqdnResourceConfig = `
+resource "loadmaster_geo_fqdn" "test" {
+  fqdn = "www.example.com."
+  selection_criteria = "rr"
+}
+`
+
+const testGeoFqdnResourceConfigUpdate = `
+resource "loadmaster_geo_fqdn" "test" {
+  fqdn = "www.example.com."
+  selection_criteria = "lb"
+  failover = true
+  site_recovery_mode = "manual"
+}
+`
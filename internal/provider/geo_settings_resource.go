@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &GeoSettingsResource{}
+var _ resource.ResourceWithImportState = &GeoSettingsResource{}
+var _ resource.ResourceWithIdentity = &GeoSettingsResource{}
+
+func NewGeoSettingsResource() resource.Resource {
+	return &GeoSettingsResource{}
+}
+
+type GeoSettingsResource struct {
+	client *api.Client
+}
+
+type GeoSettingsResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Ttl               types.Int32  `tfsdk:"ttl"`
+	SourceOfAuthority types.String `tfsdk:"source_of_authority"`
+	NameServer        types.String `tfsdk:"name_server"`
+	SoaEmail          types.String `tfsdk:"soa_email"`
+}
+
+func (r *GeoSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_geo_settings"
+}
+
+func (r *GeoSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the geo settings, always `geo_settings`.")
+}
+
+func (r *GeoSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the DNS settings of the GEO module of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource only removes it from the state, the settings on the LoadMaster are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `geo_settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ttl": schema.Int32Attribute{
+				MarkdownDescription: "The time to live in seconds of the DNS responses.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"source_of_authority": schema.StringAttribute{
+				MarkdownDescription: "The zone name used in the start of authority record.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name_server": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified name of the name server of the zone.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"soa_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the person responsible for the zone.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *GeoSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeoSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GeoSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.GeoSettingsResponse, error) {
+		return r.client.ModifyGeoSettings(r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create geo settings, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource geo settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GeoSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoSettingsResponse, error) {
+		return r.client.ShowGeoSettings()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GeoSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoSettingsResponse, error) {
+		return r.client.ModifyGeoSettings(r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update geo settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GeoSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed geo settings from state, the settings on the LoadMaster are kept")
+}
+
+func (r *GeoSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data GeoSettingsResourceModel
+
+	operation := ClientBackoff(func() (*api.GeoSettingsResponse, error) {
+		return r.client.ShowGeoSettings()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read geo settings for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *GeoSettingsResource) parameters(data GeoSettingsResourceModel) api.GeoSettingsParameters {
+	return api.GeoSettingsParameters{
+		TTL:               data.Ttl.ValueInt32Pointer(),
+		SourceOfAuthority: data.SourceOfAuthority.ValueString(),
+		NameServer:        data.NameServer.ValueString(),
+		SOAEmail:          data.SoaEmail.ValueString(),
+	}
+}
+
+func (r *GeoSettingsResource) fromResponse(data *GeoSettingsResourceModel, response *api.GeoSettingsResponse) {
+	data.Id = types.StringValue("geo_settings")
+	data.Ttl = types.Int32Value(response.TTL)
+	data.SourceOfAuthority = types.StringValue(response.SourceOfAuthority)
+	data.NameServer = types.StringValue(response.NameServer)
+	data.SoaEmail = types.StringValue(response.SOAEmail)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestGeoSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testGeoSettingsResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_settings.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("geo_settings"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_settings.test",
+						tfjsonpath.New("ttl"),
+						knownvalue.Int32Exact(30),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_geo_settings.test",
+				ImportState:       true,
+				ImportStateId:     "geo_settings",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testGeoSettingsResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_geo_settings.test",
+						tfjsonpath.New("ttl"),
+						knownvalue.Int32Exact(60),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testGeoSettingsResourceConfig = `
+resource "loadmaster_geo_settings" "test" {
+  ttl = 30
+  source_of_authority = "example.com."
+  name_server = "ns1.example.com."
+  soa_email = "hostmaster.example.com."
+}
+`
+
+const testGeoSettingsResourceConfigUpdate = `
+resource "loadmaster_geo_settings" "test" {
+  ttl = 60
+  source_of_authority = "example.com."
+  name_server = "ns1.example.com."
+  soa_email = "hostmaster.example.com."
+}
+`
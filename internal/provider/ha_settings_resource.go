@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &HASettingsResource{}
+var _ resource.ResourceWithImportState = &HASettingsResource{}
+var _ resource.ResourceWithValidateConfig = &HASettingsResource{}
+var _ resource.ResourceWithIdentity = &HASettingsResource{}
+
+func NewHASettingsResource() resource.Resource {
+	return &HASettingsResource{}
+}
+
+type HASettingsResource struct {
+	client *api.Client
+}
+
+type HASettingsResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Mode                 types.String `tfsdk:"mode"`
+	SharedAddress        types.String `tfsdk:"shared_address"`
+	PartnerAddress       types.String `tfsdk:"partner_address"`
+	HeartbeatInterfaceId types.Int32  `tfsdk:"heartbeat_interface_id"`
+	SwitchToPreferred    types.Bool   `tfsdk:"switch_to_preferred"`
+	InterHATLS           types.Bool   `tfsdk:"inter_ha_tls"`
+	Status               types.String `tfsdk:"status"`
+}
+
+func (r *HASettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ha_settings"
+}
+
+func (r *HASettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the high availability settings, always `ha_settings`.")
+}
+
+func (r *HASettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the high availability settings of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Both units of a pair are configured with their own provider, the first unit with mode `first` and the second unit with mode `second`. Destroying the resource only removes it from the state, the pair is not split up.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `ha_settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The high availability mode of this unit. One of `single`, `first` or `second`.",
+				Required:            true,
+			},
+			"shared_address": schema.StringAttribute{
+				MarkdownDescription: "The address shared by both units, it always points to the active unit. Required unless the mode is `single`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"partner_address": schema.StringAttribute{
+				MarkdownDescription: "The address of the other unit of the pair. Required unless the mode is `single`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"heartbeat_interface_id": schema.Int32Attribute{
+				MarkdownDescription: "The id of the interface the heartbeat is sent on.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"switch_to_preferred": schema.BoolAttribute{
+				MarkdownDescription: "Whether the first unit becomes active again as soon as it is available after a failover.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"inter_ha_tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether the configuration is synchronized between the units over TLS.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Whether this unit is currently `active` or `standby`. Units in mode `single` are always `active`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *HASettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HASettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HASettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.IsUnknown() {
+		return
+	}
+
+	switch data.Mode.ValueString() {
+	case "single":
+		return
+	case "first", "second":
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mode"),
+			"Invalid HA Mode",
+			fmt.Sprintf("The mode must be one of `single`, `first` or `second`, got: %s", data.Mode.ValueString()),
+		)
+		return
+	}
+
+	for name, address := range map[string]types.String{"shared_address": data.SharedAddress, "partner_address": data.PartnerAddress} {
+		if address.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing HA Address",
+				fmt.Sprintf("The attribute `%s` is required when the mode is `%s`.", name, data.Mode.ValueString()),
+			)
+			continue
+		}
+
+		if !address.IsUnknown() && net.ParseIP(address.ValueString()) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid HA Address",
+				fmt.Sprintf("The attribute `%s` must be an IP address, got: %s", name, address.ValueString()),
+			)
+		}
+	}
+
+	if !data.SharedAddress.IsUnknown() && !data.SharedAddress.IsNull() && data.SharedAddress.Equal(data.PartnerAddress) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("partner_address"),
+			"Invalid HA Address",
+			"The partner address must differ from the shared address.",
+		)
+	}
+}
+
+func (r *HASettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HASettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.HAResponse, error) {
+		return r.client.ModifyHA(r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ha settings, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource ha settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *HASettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HASettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.HAResponse, error) {
+		return r.client.ShowHA()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ha settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *HASettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HASettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.HAResponse, error) {
+		return r.client.ModifyHA(r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ha settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HASettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed ha settings from state, the pair on the LoadMaster is kept")
+}
+
+func (r *HASettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data HASettingsResourceModel
+
+	operation := ClientBackoff(func() (*api.HAResponse, error) {
+		return r.client.ShowHA()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ha settings for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *HASettingsResource) parameters(data HASettingsResourceModel) api.HAParameters {
+	return api.HAParameters{
+		Mode:               data.Mode.ValueString(),
+		SharedAddress:      data.SharedAddress.ValueString(),
+		PartnerAddress:     data.PartnerAddress.ValueString(),
+		HeartbeatInterface: data.HeartbeatInterfaceId.ValueInt32Pointer(),
+		SwitchToPreferred:  data.SwitchToPreferred.ValueBoolPointer(),
+		InterHATLS:         data.InterHATLS.ValueBoolPointer(),
+	}
+}
+
+func (r *HASettingsResource) fromResponse(data *HASettingsResourceModel, response *api.HAResponse) {
+	data.Id = types.StringValue("ha_settings")
+	data.Mode = types.StringValue(response.Mode)
+	data.SharedAddress = types.StringValue(response.SharedAddress)
+	data.PartnerAddress = types.StringValue(response.PartnerAddress)
+	data.HeartbeatInterfaceId = types.Int32Value(response.HeartbeatInterface)
+	data.SwitchToPreferred = types.BoolValue(response.SwitchToPreferred)
+	data.InterHATLS = types.BoolValue(response.InterHATLS)
+	data.Status = types.StringValue(response.Status)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestHASettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testHASettingsResourceConfigMissingAddress,
+				ExpectError: regexp.MustCompile("Missing HA Address"),
+			},
+			// Create and Read testing
+			{
+				Config: testHASettingsResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_ha_settings.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("ha_settings"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_ha_settings.test",
+						tfjsonpath.New("mode"),
+						knownvalue.StringExact("single"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_ha_settings.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("active"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_ha_settings.test",
+				ImportState:       true,
+				ImportStateId:     "ha_settings",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testHASettingsResourceConfig = `
+resource "loadmaster_ha_settings" "test" {
+  mode = "single"
+}
+`
+
+const testHASettingsResourceConfigMissingAddress = `
+resource "loadmaster_ha_settings" "test" {
+  mode = "first"
+  partner_address = "192.0.2.12"
+}
+`
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+// virtualServiceKey is the natural key of a virtual service, which is unique
+// on the LoadMaster unlike its index, which changes when it is recreated.
+type virtualServiceKey struct {
+	Address  string
+	Port     string
+	Protocol string
+}
+
+func (k virtualServiceKey) String() string {
+	return net.JoinHostPort(k.Address, k.Port) + ":" + k.Protocol
+}
+
+// realServerKey is the natural key of a real server within its virtual
+// service.
+type realServerKey struct {
+	VirtualService virtualServiceKey
+	Address        string
+	Port           string
+}
+
+func (k realServerKey) String() string {
+	return k.VirtualService.String() + "/" + net.JoinHostPort(k.Address, k.Port)
+}
+
+// isNaturalKey returns whether an import ID is a natural key rather than an
+// index. Indexes never contain a colon.
+func isNaturalKey(id string) bool {
+	return strings.Contains(id, ":")
+}
+
+// parseVirtualServiceKey parses `address:port:protocol`, with IPv6 addresses
+// in brackets.
+func parseVirtualServiceKey(id string) (virtualServiceKey, error) {
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return virtualServiceKey{}, fmt.Errorf("expected `address:port:protocol`, got: %s", id)
+	}
+
+	address, port, err := net.SplitHostPort(id[:i])
+	if err != nil {
+		return virtualServiceKey{}, fmt.Errorf("expected `address:port:protocol` with IPv6 addresses in brackets, got: %s", id)
+	}
+
+	key := virtualServiceKey{Address: address, Port: port, Protocol: strings.ToLower(id[i+1:])}
+
+	if key.Protocol != "tcp" && key.Protocol != "udp" {
+		return virtualServiceKey{}, fmt.Errorf("expected protocol `tcp` or `udp`, got: %s", id[i+1:])
+	}
+	if !validPort(port, true) {
+		return virtualServiceKey{}, fmt.Errorf("expected a port or a range of ports, got: %s", port)
+	}
+
+	return key, nil
+}
+
+// parseRealServerKey parses `vs_address:vs_port:protocol/rs_address:rs_port`,
+// with IPv6 addresses in brackets.
+func parseRealServerKey(id string) (realServerKey, error) {
+	vs, rs, ok := strings.Cut(id, "/")
+	if !ok {
+		return realServerKey{}, fmt.Errorf("expected `vs_address:vs_port:protocol/rs_address:rs_port`, got: %s", id)
+	}
+
+	vsKey, err := parseVirtualServiceKey(vs)
+	if err != nil {
+		return realServerKey{}, err
+	}
+
+	address, port, err := net.SplitHostPort(rs)
+	if err != nil {
+		return realServerKey{}, fmt.Errorf("expected `rs_address:rs_port` with IPv6 addresses in brackets, got: %s", rs)
+	}
+	if !validPort(port, false) {
+		return realServerKey{}, fmt.Errorf("expected a port, got: %s", port)
+	}
+
+	return realServerKey{VirtualService: vsKey, Address: address, Port: port}, nil
+}
+
+// sameAddress compares two addresses, IP addresses by value so that different
+// notations of IPv6 addresses match.
+func sameAddress(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA != nil && ipB != nil {
+		return ipA.Equal(ipB)
+	}
+
+	return strings.EqualFold(a, b)
+}
+
+// matches returns whether the virtual service has the key. Sub virtual
+// services have no address and never match.
+func (k virtualServiceKey) matches(vs api.VirtualServiceResponse) bool {
+	return vs.MasterVSID == 0 && sameAddress(vs.Address, k.Address) && vs.Port == k.Port && strings.EqualFold(vs.Protocol, k.Protocol)
+}
+
+// matchVirtualServices returns the virtual services for which match is true.
+func matchVirtualServices(virtualServices []api.VirtualServiceResponse, match func(vs api.VirtualServiceResponse) bool) []api.VirtualServiceResponse {
+	var matches []api.VirtualServiceResponse
+
+	for _, vs := range virtualServices {
+		if match(vs) {
+			matches = append(matches, vs)
+		}
+	}
+
+	return matches
+}
+
+// matchRealServers returns the real servers with the address and port.
+func matchRealServers(realServers []api.RealServer, address string, port string) []api.RealServer {
+	var matches []api.RealServer
+
+	for _, rs := range realServers {
+		if sameAddress(rs.Address, address) && strconv.Itoa(int(rs.Port)) == port {
+			matches = append(matches, rs)
+		}
+	}
+
+	return matches
+}
+
+// resolveVirtualServiceKey returns the index of the virtual service with the
+// key by listing the virtual services of the LoadMaster.
+func resolveVirtualServiceKey(ctx context.Context, client *api.Client, key virtualServiceKey) (string, diag.Diagnostics) {
+	return lookupVirtualService(ctx, client, "virtual service", key.String(), key.matches)
+}
+
+// lookupVirtualService returns the index of the only virtual service for which
+// match is true by listing the virtual services of the LoadMaster. The noun
+// and description of the match are used in errors.
+func lookupVirtualService(ctx context.Context, client *api.Client, noun string, description string, match func(vs api.VirtualServiceResponse) bool) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+		return client.ListVirtualServices()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list virtual services, got error: %s", err))
+		return "", diags
+	}
+
+	matches := matchVirtualServices(response.VS, match)
+	switch len(matches) {
+	case 0:
+		diags.AddError("Not Found", fmt.Sprintf("No %s matches %s.", noun, description))
+		return "", diags
+	case 1:
+		return strconv.Itoa(int(matches[0].Index)), diags
+	}
+
+	indexes := make([]string, len(matches))
+	for i, vs := range matches {
+		indexes[i] = strconv.Itoa(int(vs.Index))
+	}
+	diags.AddError("Ambiguous Match", fmt.Sprintf("The %ss %s all match %s, select one of them by its index.", noun, strings.Join(indexes, ", "), description))
+
+	return "", diags
+}
+
+// resolveRealServerKey returns the indexes of the virtual service and the
+// real server with the key by listing the LoadMaster.
+func resolveRealServerKey(ctx context.Context, client *api.Client, key realServerKey) (string, string, diag.Diagnostics) {
+	vs, diags := resolveVirtualServiceKey(ctx, client, key.VirtualService)
+	if diags.HasError() {
+		return "", "", diags
+	}
+
+	operation := ClientBackoff(func() (*api.ListRealServerResponse, error) {
+		return client.ListRealServers(vs)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list real servers of virtual service %s, got error: %s", vs, err))
+		return "", "", diags
+	}
+
+	matches := matchRealServers(response.Rs, key.Address, key.Port)
+	switch len(matches) {
+	case 0:
+		diags.AddError("Not Found", fmt.Sprintf("No real server matches %s.", key))
+		return "", "", diags
+	case 1:
+		return vs, strconv.Itoa(int(matches[0].RsIndex)), diags
+	}
+
+	indexes := make([]string, len(matches))
+	for i, rs := range matches {
+		indexes[i] = strconv.Itoa(int(rs.RsIndex))
+	}
+	diags.AddError("Ambiguous Match", fmt.Sprintf("The real servers %s of virtual service %s all match %s, import one of them as `%s/<index>`.", strings.Join(indexes, ", "), vs, key, vs))
+
+	return "", "", diags
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestParseVirtualServiceKey(t *testing.T) {
+	tests := []struct {
+		id   string
+		want virtualServiceKey
+		err  bool
+	}{
+		{id: "10.0.0.4:443:tcp", want: virtualServiceKey{Address: "10.0.0.4", Port: "443", Protocol: "tcp"}},
+		{id: "10.0.0.4:53:UDP", want: virtualServiceKey{Address: "10.0.0.4", Port: "53", Protocol: "udp"}},
+		{id: "[2001:db8::1]:443:tcp", want: virtualServiceKey{Address: "2001:db8::1", Port: "443", Protocol: "tcp"}},
+		{id: "2001:db8::1:443:tcp", err: true},
+		{id: "10.0.0.4:443", err: true},
+		{id: "10.0.0.4:https:tcp", err: true},
+		{id: "10.0.0.4:443:sctp", err: true},
+		{id: "12", err: true},
+	}
+
+	for _, tc := range tests {
+		got, err := parseVirtualServiceKey(tc.id)
+		if tc.err {
+			if err == nil {
+				t.Errorf("parseVirtualServiceKey(%q): expected an error", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseVirtualServiceKey(%q): unexpected error: %s", tc.id, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("parseVirtualServiceKey(%q) = %+v, want %+v", tc.id, got, tc.want)
+		}
+	}
+}
+
+func TestParseRealServerKey(t *testing.T) {
+	got, err := parseRealServerKey("[2001:db8::1]:443:tcp/[2001:db8::10]:8443")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := realServerKey{
+		VirtualService: virtualServiceKey{Address: "2001:db8::1", Port: "443", Protocol: "tcp"},
+		Address:        "2001:db8::10",
+		Port:           "8443",
+	}
+	if got != want {
+		t.Errorf("parseRealServerKey() = %+v, want %+v", got, want)
+	}
+	if got.String() != "[2001:db8::1]:443:tcp/[2001:db8::10]:8443" {
+		t.Errorf("unexpected string: %s", got)
+	}
+
+	for _, id := range []string{"10.0.0.4:443:tcp", "10.0.0.4:443:tcp/10.0.0.10", "10.0.0.4:443/10.0.0.10:80"} {
+		if _, err := parseRealServerKey(id); err == nil {
+			t.Errorf("parseRealServerKey(%q): expected an error", id)
+		}
+	}
+}
+
+func TestMatchVirtualServices(t *testing.T) {
+	virtualServices := []api.VirtualServiceResponse{
+		{Index: 1, Address: "10.0.0.4", Port: "443", Protocol: "tcp"},
+		{Index: 2, Address: "10.0.0.4", Port: "443", Protocol: "udp"},
+		{Index: 3, Address: "2001:db8:0::1", Port: "443", Protocol: "tcp"},
+		{Index: 4, MasterVSID: 1},
+	}
+
+	tests := []struct {
+		key  virtualServiceKey
+		want []int32
+	}{
+		{virtualServiceKey{"10.0.0.4", "443", "tcp"}, []int32{1}},
+		{virtualServiceKey{"10.0.0.4", "443", "udp"}, []int32{2}},
+		{virtualServiceKey{"2001:db8::1", "443", "tcp"}, []int32{3}},
+		{virtualServiceKey{"10.0.0.4", "80", "tcp"}, nil},
+	}
+
+	for _, tc := range tests {
+		matches := matchVirtualServices(virtualServices, tc.key.matches)
+		if len(matches) != len(tc.want) {
+			t.Errorf("matchVirtualServices(%s) returned %d matches, want %d", tc.key, len(matches), len(tc.want))
+			continue
+		}
+		for i, vs := range matches {
+			if vs.Index != tc.want[i] {
+				t.Errorf("matchVirtualServices(%s)[%d] = %d, want %d", tc.key, i, vs.Index, tc.want[i])
+			}
+		}
+	}
+}
+
+func TestMatchRealServers(t *testing.T) {
+	realServers := []api.RealServer{
+		{RsIndex: 1, Address: "10.0.0.10", Port: 80},
+		{RsIndex: 2, Address: "10.0.0.10", Port: 8080},
+		{RsIndex: 3, Address: "backend.example.com", Port: 80},
+	}
+
+	if matches := matchRealServers(realServers, "10.0.0.10", "8080"); len(matches) != 1 || matches[0].RsIndex != 2 {
+		t.Errorf("expected real server 2, got %+v", matches)
+	}
+	if matches := matchRealServers(realServers, "Backend.example.com", "80"); len(matches) != 1 || matches[0].RsIndex != 3 {
+		t.Errorf("expected real server 3, got %+v", matches)
+	}
+	if matches := matchRealServers(realServers, "10.0.0.11", "80"); len(matches) != 0 {
+		t.Errorf("expected no match, got %+v", matches)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &InterfaceAddressResource{}
+var _ resource.ResourceWithImportState = &InterfaceAddressResource{}
+var _ resource.ResourceWithValidateConfig = &InterfaceAddressResource{}
+var _ resource.ResourceWithIdentity = &InterfaceAddressResource{}
+
+func NewInterfaceAddressResource() resource.Resource {
+	return &InterfaceAddressResource{}
+}
+
+type InterfaceAddressResource struct {
+	client *api.Client
+}
+
+type InterfaceAddressResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	InterfaceId types.Int32  `tfsdk:"interface_id"`
+	Address     types.String `tfsdk:"address"`
+}
+
+type InterfaceAddressIdentityModel struct {
+	InterfaceId types.Int64  `tfsdk:"interface_id"`
+	Address     types.String `tfsdk:"address"`
+}
+
+func (r *InterfaceAddressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_interface_address"
+}
+
+func (r *InterfaceAddressResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"interface_id": identityschema.Int64Attribute{
+				Description:       "Identifier of the interface.",
+				RequiredForImport: true,
+			},
+			"address": identityschema.StringAttribute{
+				Description:       "The additional address in CIDR notation.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *InterfaceAddressResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an additional address of a network interface.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the address in the format `interface_id/address`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface_id": schema.Int32Attribute{
+				MarkdownDescription: "The id of the interface.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The additional address in CIDR notation, e.g. `10.0.1.10/24`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfaceAddressResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InterfaceAddressResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data InterfaceAddressResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Address.IsNull() || data.Address.IsUnknown() {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(data.Address.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Invalid Interface Address",
+			fmt.Sprintf("The address must be in CIDR notation, got: %s", data.Address.ValueString()),
+		)
+	}
+}
+
+func (r *InterfaceAddressResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InterfaceAddressResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "interface_id", data.InterfaceId)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.AddInterfaceAddress(strconv.Itoa(int(data.InterfaceId.ValueInt32())), data.Address.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create interface address, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Id = types.StringValue(strconv.Itoa(int(data.InterfaceId.ValueInt32())) + "/" + data.Address.ValueString())
+
+	tflog.Trace(ctx, "created a resource interface address")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, InterfaceAddressIdentityModel{InterfaceId: types.Int64Value(int64(data.InterfaceId.ValueInt32())), Address: data.Address})...)
+}
+
+func (r *InterfaceAddressResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InterfaceAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ShowInterface(strconv.Itoa(int(data.InterfaceId.ValueInt32())))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isInterfaceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read interface address, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	if !slices.Contains(response.AdditionalAddresses, data.Address.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, InterfaceAddressIdentityModel{InterfaceId: types.Int64Value(int64(data.InterfaceId.ValueInt32())), Address: data.Address})...)
+}
+
+func (r *InterfaceAddressResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data InterfaceAddressResourceModel
+
+	// All attributes require a replacement, the plan only ever carries the computed id.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceAddressResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InterfaceAddressResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteInterfaceAddress(strconv.Itoa(int(data.InterfaceId.ValueInt32())), data.Address.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete interface address, got error: %s", err))
+		return
+	}
+}
+
+func (r *InterfaceAddressResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data InterfaceAddressResourceModel
+
+	id := req.ID
+	if identity, ok := importIdentity[InterfaceAddressIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		id = fmt.Sprintf("%d/%s", identity.InterfaceId.ValueInt64(), identity.Address.ValueString())
+	}
+
+	id_list := strings.SplitN(id, "/", 2)
+
+	if len(id_list) != 2 {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s, expected `interface_id/address`", id))
+		return
+	}
+
+	interface_id, err := strconv.Atoi(id_list[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse interface id: %s", id_list[0]))
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ShowInterface(id_list[0])
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read interface address for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	if !slices.Contains(response.AdditionalAddresses, id_list[1]) {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("The interface %s has no additional address %s", id_list[0], id_list[1]))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	data.InterfaceId = types.Int32Value(int32(interface_id))
+	data.Address = types.StringValue(id_list[1])
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, InterfaceAddressIdentityModel{InterfaceId: types.Int64Value(int64(data.InterfaceId.ValueInt32())), Address: data.Address})...)
+}
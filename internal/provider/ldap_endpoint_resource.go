@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &LdapEndpointResource{}
+var _ resource.ResourceWithImportState = &LdapEndpointResource{}
+var _ resource.ResourceWithValidateConfig = &LdapEndpointResource{}
+var _ resource.ResourceWithIdentity = &LdapEndpointResource{}
+
+func NewLdapEndpointResource() resource.Resource {
+	return &LdapEndpointResource{}
+}
+
+type LdapEndpointResource struct {
+	client *api.Client
+}
+
+type LdapEndpointResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Servers             types.List   `tfsdk:"servers"`
+	Security            types.String `tfsdk:"security"`
+	BindDn              types.String `tfsdk:"bind_dn"`
+	BindPassword        types.String `tfsdk:"bind_password"`
+	BindPasswordVersion types.Int32  `tfsdk:"bind_password_version"`
+	Timeout             types.Int32  `tfsdk:"timeout"`
+	AdminGroup          types.String `tfsdk:"admin_group"`
+}
+
+func (r *LdapEndpointResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_endpoint"
+}
+
+func (r *LdapEndpointResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("name", "The name of the LDAP endpoint.")
+}
+
+func (r *LdapEndpointResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an LDAP endpoint of the LoadMaster. An endpoint is referenced by its name from the admin login policy and from SSO domains.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the endpoint, same as `name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the endpoint.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"servers": schema.ListAttribute{
+				MarkdownDescription: "The LDAP servers in the order they are tried, as `address` or `address:port`.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"security": schema.StringAttribute{
+				MarkdownDescription: "The transport security used to connect to the servers. One of `unencrypted`, `starttls` or `ldaps`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bind_dn": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name used to bind to the servers.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"bind_password": schema.StringAttribute{
+				MarkdownDescription: "The password used to bind to the servers. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"bind_password_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `bind_password` to the LoadMaster again.",
+				Optional:            true,
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "The timeout of a request to a server in seconds, between 5 and 60.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"admin_group": schema.StringAttribute{
+				MarkdownDescription: "The group whose members are allowed to log in as administrator.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LdapEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LdapEndpointResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data LdapEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, diags := stringListValues(ctx, data.Servers)
+	resp.Diagnostics.Append(diags...)
+
+	if servers != nil && len(servers) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("servers"),
+			"Missing LDAP Server",
+			"At least one LDAP server must be configured.",
+		)
+	}
+
+	for _, server := range servers {
+		if !validServerAddress(server) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("servers"),
+				"Invalid LDAP Server",
+				fmt.Sprintf("The servers must be given as `address` or `address:port`, got: %s", server),
+			)
+		}
+	}
+
+	if !data.Security.IsNull() && !data.Security.IsUnknown() {
+		switch data.Security.ValueString() {
+		case "unencrypted", "starttls", "ldaps":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("security"),
+				"Invalid LDAP Security",
+				fmt.Sprintf("The security must be one of `unencrypted`, `starttls` or `ldaps`, got: %s", data.Security.ValueString()),
+			)
+		}
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() {
+		if timeout := data.Timeout.ValueInt32(); timeout < 5 || timeout > 60 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid LDAP Timeout",
+				fmt.Sprintf("The timeout must be between 5 and 60 seconds, got: %d", timeout),
+			)
+		}
+	}
+}
+
+func (r *LdapEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LdapEndpointResourceModel
+	var config LdapEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "name", data.Name)
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters, diags := r.parameters(ctx, data, config.BindPassword)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LdapEndpointResponse, error) {
+		return r.client.AddLdapEndpoint(data.Name.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ldap endpoint, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource ldap endpoint")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *LdapEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LdapEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LdapEndpointResponse, error) {
+		return r.client.ShowLdapEndpoint(data.Name.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Message == "Unknown LDAP endpoint" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ldap endpoint, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *LdapEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LdapEndpointResourceModel
+	var config LdapEndpointResourceModel
+	var state LdapEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The bind password is only sent again when its version changes.
+	password := types.StringNull()
+	if !data.BindPasswordVersion.Equal(state.BindPasswordVersion) {
+		password = config.BindPassword
+	}
+
+	parameters, diags := r.parameters(ctx, data, password)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LdapEndpointResponse, error) {
+		return r.client.ModifyLdapEndpoint(data.Name.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ldap endpoint, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LdapEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LdapEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteLdapEndpoint(data.Name.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ldap endpoint, got error: %s", err))
+		return
+	}
+}
+
+func (r *LdapEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data LdapEndpointResourceModel
+
+	name := importAttribute(ctx, req, "name", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.LdapEndpointResponse, error) {
+		return r.client.ShowLdapEndpoint(name)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ldap endpoint for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Name = types.StringValue(name)
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *LdapEndpointResource) parameters(ctx context.Context, data LdapEndpointResourceModel, password types.String) (api.LdapEndpointParameters, diag.Diagnostics) {
+	servers, diags := stringListValues(ctx, data.Servers)
+
+	return api.LdapEndpointParameters{
+		Servers:      servers,
+		Security:     data.Security.ValueString(),
+		BindDN:       data.BindDn.ValueString(),
+		BindPassword: password.ValueStringPointer(),
+		Timeout:      data.Timeout.ValueInt32Pointer(),
+		AdminGroup:   data.AdminGroup.ValueString(),
+	}, diags
+}
+
+func (r *LdapEndpointResource) fromResponse(ctx context.Context, data *LdapEndpointResourceModel, response *api.LdapEndpointResponse) diag.Diagnostics {
+	servers, diags := stringListValue(ctx, response.Servers)
+
+	data.Id = types.StringValue(data.Name.ValueString())
+	data.Servers = servers
+	data.Security = types.StringValue(response.Security)
+	data.BindDn = types.StringValue(response.BindDN)
+	data.BindPassword = types.StringNull()
+	data.Timeout = types.Int32Value(response.Timeout)
+	data.AdminGroup = types.StringValue(response.AdminGroup)
+
+	return diags
+}
+
+// validServerAddress reports whether server is an address or hostname,
+// optionally followed by a port.
+func validServerAddress(server string) bool {
+	host := server
+	if h, port, err := net.SplitHostPort(server); err == nil {
+		p, err := strconv.Atoi(port)
+		if err != nil || p < 1 || p > 65535 {
+			return false
+		}
+		host = h
+	}
+
+	if host == "" {
+		return false
+	}
+
+	if net.ParseIP(host) != nil {
+		return true
+	}
+
+	for _, c := range []byte(host) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '.':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
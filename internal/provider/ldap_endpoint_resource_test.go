@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestLdapEndpointResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testLdapEndpointResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_ldap_endpoint.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("tfcorp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_ldap_endpoint.test",
+						tfjsonpath.New("servers"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("192.0.2.30"),
+							knownvalue.StringExact("192.0.2.31:636"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_ldap_endpoint.test",
+				ImportState:             true,
+				ImportStateId:           "tfcorp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bind_password_version"},
+			},
+			{
+				Config: testLdapEndpointResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_ldap_endpoint.test",
+						tfjsonpath.New("security"),
+						knownvalue.StringExact("starttls"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_ldap_endpoint.test",
+						tfjsonpath.New("timeout"),
+						knownvalue.Int32Exact(20),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidServerAddress(t *testing.T) {
+	for server, expected := range map[string]bool{
+		"192.0.2.30":           true,
+		"192.0.2.30:636":       true,
+		"[2001:db8::1]:636":    true,
+		"2001:db8::1":          true,
+		"ldap.example.com":     true,
+		"ldap.example.com:389": true,
+		"":                     false,
+		"192.0.2.30:0":         false,
+		"ldap example.com":     false,
+	} {
+		if got := validServerAddress(server); got != expected {
+			t.Errorf("validServerAddress(%q) = %t, expected %t", server, got, expected)
+		}
+	}
+}
+
+const testLdapEndpointResourceConfig = `
+resource "loadmaster_ldap_endpoint" "test" {
+  name = "tfcorp"
+  servers = ["192.0.2.30", "192.0.2.31:636"]
+  security = "ldaps"
+  bind_dn = "cn=loadmaster,dc=example,dc=com"
+  bind_password = "bindpassword"
+  bind_password_version = 1
+}
+`
+
+const testLdapEndpointResourceConfigUpdate = `
+resource "loadmaster_ldap_endpoint" "test" {
+  name = "tfcorp"
+  servers = ["192.0.2.30", "192.0.2.31:636"]
+  security = "starttls"
+  bind_dn = "cn=loadmaster,dc=example,dc=com"
+  bind_password = "bindpassword"
+  bind_password_version = 1
+  timeout = 20
+}
+`
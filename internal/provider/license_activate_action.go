@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ action.Action = &LicenseActivateAction{}
+var _ action.ActionWithConfigure = &LicenseActivateAction{}
+
+func NewLicenseActivateAction() action.Action {
+	return &LicenseActivateAction{}
+}
+
+type LicenseActivateAction struct {
+	client *api.Client
+}
+
+type LicenseActivateActionModel struct {
+	License types.String `tfsdk:"license"`
+}
+
+func (a *LicenseActivateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_activate"
+}
+
+func (a *LicenseActivateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This action activates a license on the LoadMaster without access to the licensing server, using a license blob obtained offline.",
+		Attributes: map[string]schema.Attribute{
+			"license": schema.StringAttribute{
+				MarkdownDescription: "The license blob. This value is write-only, so it can be read from an ephemeral resource.",
+				Required:            true,
+				WriteOnly:           true,
+			},
+		},
+	}
+}
+
+func (a *LicenseActivateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *LicenseActivateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data LicenseActivateActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Activating license on the LoadMaster"})
+
+	// The activation is not retried, the license server of the LoadMaster
+	// rejects a blob which was already used.
+	response, err := a.client.ActivateLicenseOffline(data.License.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error activating license",
+			fmt.Sprintf("Could not activate the license: %s", err.Error()),
+		)
+		return
+	}
+
+	licenses.Delete(a.client)
+
+	tflog.Info(ctx, "Activated license", map[string]any{"type": response.Type, "expiry": response.Expiry})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Activated %s license, expiring %s", response.Type, response.Expiry),
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cenkalti/backoff/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var (
+	_ datasource.DataSource              = &LicenseDataSource{}
+	_ datasource.DataSourceWithConfigure = &LicenseDataSource{}
+)
+
+// licenses caches the license of every client, so the plan time feature
+// checks only read it once per run.
+var licenses sync.Map
+
+func NewLicenseDataSource() datasource.DataSource {
+	return &LicenseDataSource{}
+}
+
+type LicenseDataSource struct {
+	client *api.Client
+}
+
+type LicenseDataSourceModel struct {
+	Type           types.String `tfsdk:"type"`
+	Expiry         types.String `tfsdk:"expiry"`
+	Activated      types.Bool   `tfsdk:"activated"`
+	WAF            types.Bool   `tfsdk:"waf"`
+	ESP            types.Bool   `tfsdk:"esp"`
+	GEO            types.Bool   `tfsdk:"geo"`
+	ThroughputTier types.String `tfsdk:"throughput_tier"`
+}
+
+func (d *LicenseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license"
+}
+
+func (d *LicenseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Use this data source to retrieve the license of the LoadMaster.\n\n" +
+			"The provider uses the same information during plan to reject configuration which requires a feature that is not licensed, " +
+			"e.g. an `esp` block on a virtual service without an ESP subscription.",
+
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the license.",
+				Computed:            true,
+			},
+			"expiry": schema.StringAttribute{
+				MarkdownDescription: "The date the license expires, empty for a perpetual license.",
+				Computed:            true,
+			},
+			"activated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license is activated.",
+				Computed:            true,
+			},
+			"waf": schema.BoolAttribute{
+				MarkdownDescription: "Whether the web application firewall is licensed.",
+				Computed:            true,
+			},
+			"esp": schema.BoolAttribute{
+				MarkdownDescription: "Whether the edge security pack is licensed.",
+				Computed:            true,
+			},
+			"geo": schema.BoolAttribute{
+				MarkdownDescription: "Whether global server load balancing (GEO) is licensed.",
+				Computed:            true,
+			},
+			"throughput_tier": schema.StringAttribute{
+				MarkdownDescription: "The licensed throughput, e.g. `10Gbps`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LicenseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LicenseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LicenseDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := readLicense(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read license, got error: %s", err))
+		return
+	}
+
+	data.Type = types.StringValue(response.Type)
+	data.Expiry = types.StringValue(response.Expiry)
+	data.Activated = types.BoolValue(response.Activated)
+	data.WAF = types.BoolValue(response.WAF)
+	data.ESP = types.BoolValue(response.ESP)
+	data.GEO = types.BoolValue(response.GEO)
+	data.ThroughputTier = types.StringValue(response.ThroughputTier)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readLicense returns the license of the LoadMaster, it is read from the
+// LoadMaster only once per client.
+func readLicense(ctx context.Context, client *api.Client) (*api.LicenseResponse, error) {
+	if license, ok := licenses.Load(client); ok {
+		return license.(*api.LicenseResponse), nil
+	}
+
+	operation := ClientBackoff(func() (*api.LicenseResponse, error) {
+		return client.ShowLicense()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	licenses.Store(client, response)
+
+	return response, nil
+}
+
+// licensedFeature returns whether the feature, one of `waf`, `esp` or `geo`,
+// is part of the license.
+func licensedFeature(license *api.LicenseResponse, feature string) bool {
+	switch feature {
+	case "waf":
+		return license.WAF
+	case "esp":
+		return license.ESP
+	case "geo":
+		return license.GEO
+	}
+
+	return true
+}
+
+// requireLicensedFeature adds an error to the attribute if the feature is not
+// part of the license of the LoadMaster. It is called during plan, so errors
+// reading the license are only logged.
+func requireLicensedFeature(ctx context.Context, client *api.Client, feature string, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if client == nil {
+		return diags
+	}
+
+	license, err := readLicense(ctx, client)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read license, skipping the feature check", map[string]any{"error": err.Error()})
+		return diags
+	}
+
+	if !licensedFeature(license, feature) {
+		diags.AddAttributeError(
+			attribute,
+			"Feature Not Licensed",
+			fmt.Sprintf("The configuration requires the %s feature, which is not part of the %s license of the LoadMaster. "+
+				"Check the loadmaster_license data source for the licensed features.", strings.ToUpper(feature), license.Type),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestLicenseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "loadmaster_license" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_license.test",
+						tfjsonpath.New("activated"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_license.test",
+						tfjsonpath.New("type"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestLicensedFeature(t *testing.T) {
+	license := &api.LicenseResponse{WAF: true, ESP: false, GEO: true}
+
+	tests := map[string]bool{
+		"waf":   true,
+		"esp":   false,
+		"geo":   true,
+		"other": true,
+	}
+
+	for feature, expected := range tests {
+		if actual := licensedFeature(license, feature); actual != expected {
+			t.Errorf("licensedFeature(%q): expected %t, got %t", feature, expected, actual)
+		}
+	}
+}
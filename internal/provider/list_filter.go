@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listFilterRegexp compiles a regular expression filter of a list resource,
+// a null filter matches everything.
+func listFilterRegexp(filter types.String, attribute string) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if filter.IsNull() || filter.IsUnknown() {
+		return regexp.MustCompile(""), diags
+	}
+
+	re, err := regexp.Compile(filter.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Filter",
+			fmt.Sprintf("The filter must be a valid regular expression, got error: %s", err),
+		)
+	}
+
+	return re, diags
+}
+
+// listFilterMatches returns whether the value matches an exact filter of a
+// list resource, a null filter matches everything.
+func listFilterMatches(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// listLimitReached returns whether a list resource returned the number of
+// results requested, a limit of zero means no limit.
+func listLimitReached(req list.ListRequest, count int64) bool {
+	return req.Limit > 0 && count >= req.Limit
+}
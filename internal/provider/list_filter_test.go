@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListFilterRegexp(t *testing.T) {
+	re, diags := listFilterRegexp(types.StringNull(), "name")
+	if diags.HasError() || !re.MatchString("anything") {
+		t.Errorf("expected a null filter to match everything")
+	}
+
+	re, diags = listFilterRegexp(types.StringValue("^web-"), "name")
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !re.MatchString("web-01") || re.MatchString("api-01") {
+		t.Errorf("expected the filter to only match names starting with web-")
+	}
+
+	if _, diags = listFilterRegexp(types.StringValue("[a-"), "name"); !diags.HasError() {
+		t.Errorf("expected an error for an invalid regular expression")
+	}
+}
+
+func TestListFilterMatches(t *testing.T) {
+	if !listFilterMatches(types.StringNull(), "80") {
+		t.Errorf("expected a null filter to match")
+	}
+	if !listFilterMatches(types.StringValue("80"), "80") {
+		t.Errorf("expected an equal value to match")
+	}
+	if listFilterMatches(types.StringValue("80"), "8080") {
+		t.Errorf("expected a different value not to match")
+	}
+}
+
+func TestListLimitReached(t *testing.T) {
+	for _, tc := range []struct {
+		limit int64
+		count int64
+		want  bool
+	}{
+		{0, 100, false},
+		{2, 1, false},
+		{2, 2, true},
+	} {
+		if got := listLimitReached(list.ListRequest{Limit: tc.limit}, tc.count); got != tc.want {
+			t.Errorf("listLimitReached(%d, %d) = %t, want %t", tc.limit, tc.count, got, tc.want)
+		}
+	}
+}
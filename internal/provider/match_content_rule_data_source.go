@@ -84,15 +84,15 @@ func (d *MatchContentRuleDataSource) Schema(ctx context.Context, req datasource.
 				Computed:            true,
 			},
 			"set_on_match": schema.Int32Attribute{
-				MarkdownDescription: "If the rule is successfully matched, set the specified flag.",
+				MarkdownDescription: "If the rule is successfully matched, set the specified flag, from 1 to 9. `0` means no flag.",
 				Computed:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Computed:            true,
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Computed:            true,
 			},
 			"must_fail": schema.BoolAttribute{
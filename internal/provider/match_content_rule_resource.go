@@ -20,6 +20,7 @@ import (
 
 var _ resource.Resource = &MatchContentRuleResource{}
 var _ resource.ResourceWithImportState = &MatchContentRuleResource{}
+var _ resource.ResourceWithIdentity = &MatchContentRuleResource{}
 
 func NewMatchContentRuleResource() resource.Resource {
 	return &MatchContentRuleResource{}
@@ -48,6 +49,10 @@ func (r *MatchContentRuleResource) Metadata(ctx context.Context, req resource.Me
 	resp.TypeName = req.ProviderTypeName + "_match_content_rule"
 }
 
+func (r *MatchContentRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *MatchContentRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `MatchContentRule`.",
@@ -68,6 +73,7 @@ func (r *MatchContentRuleResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "The type of matching to be performed by the rule. Should be either `regex`, `prefix` or `postfix`.",
 				Computed:            true,
 				Optional:            true,
+				Validators:          oneOf(matchTypes...),
 			},
 			"inc_host": schema.BoolAttribute{
 				MarkdownDescription: "Prepend the hostname to request URI before performing the match.",
@@ -96,19 +102,22 @@ func (r *MatchContentRuleResource) Schema(ctx context.Context, req resource.Sche
 				Default:             stringdefault.StaticString(""),
 			},
 			"set_on_match": schema.Int32Attribute{
-				MarkdownDescription: "If the rule is successfully matched, set the specified flag.",
+				MarkdownDescription: "If the rule is successfully matched, set the specified flag, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"must_fail": schema.BoolAttribute{
 				MarkdownDescription: "If this rule is matched, then always fail to connect.",
@@ -189,6 +198,7 @@ func (r *MatchContentRuleResource) Create(ctx context.Context, req resource.Crea
 	tflog.Trace(ctx, "created a resource match content rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *MatchContentRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -231,6 +241,7 @@ func (r *MatchContentRuleResource) Read(ctx context.Context, req resource.ReadRe
 	data.MustFail = types.BoolPointerValue(rule.MustFail)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *MatchContentRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -305,8 +316,10 @@ func (r *MatchContentRuleResource) Delete(ctx context.Context, req resource.Dele
 func (r *MatchContentRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data MatchContentRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -335,4 +348,5 @@ func (r *MatchContentRuleResource) ImportState(ctx context.Context, req resource
 	data.MustFail = types.BoolPointerValue(rule.MustFail)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
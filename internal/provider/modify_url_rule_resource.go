@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ModifyUrlRuleResource{}
 var _ resource.ResourceWithImportState = &ModifyUrlRuleResource{}
+var _ resource.ResourceWithIdentity = &ModifyUrlRuleResource{}
 
 func NewModifyUrlRuleResource() resource.Resource {
 	return &ModifyUrlRuleResource{}
@@ -40,6 +41,10 @@ func (r *ModifyUrlRuleResource) Metadata(ctx context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_modify_url_rule"
 }
 
+func (r *ModifyUrlRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *ModifyUrlRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `ModifyUrlRule`.",
@@ -61,14 +66,16 @@ func (r *ModifyUrlRuleResource) Schema(ctx context.Context, req resource.SchemaR
 				Required:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 		},
 	}
@@ -130,6 +137,7 @@ func (r *ModifyUrlRuleResource) Create(ctx context.Context, req resource.CreateR
 	tflog.Trace(ctx, "created a resource modify url rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ModifyUrlRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -165,6 +173,7 @@ func (r *ModifyUrlRuleResource) Read(ctx context.Context, req resource.ReadReque
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ModifyUrlRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -224,8 +233,10 @@ func (r *ModifyUrlRuleResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *ModifyUrlRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ModifyUrlRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -247,4 +258,5 @@ func (r *ModifyUrlRuleResource) ImportState(ctx context.Context, req resource.Im
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &NetworkInterfaceResource{}
+var _ resource.ResourceWithImportState = &NetworkInterfaceResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkInterfaceResource{}
+var _ resource.ResourceWithIdentity = &NetworkInterfaceResource{}
+
+func NewNetworkInterfaceResource() resource.Resource {
+	return &NetworkInterfaceResource{}
+}
+
+type NetworkInterfaceResource struct {
+	client *api.Client
+}
+
+type NetworkInterfaceResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	InterfaceId    types.Int32  `tfsdk:"interface_id"`
+	Address        types.String `tfsdk:"address"`
+	Mtu            types.Int32  `tfsdk:"mtu"`
+	DefaultGateway types.Bool   `tfsdk:"default_gateway"`
+	AdminWuiAccess types.Bool   `tfsdk:"admin_wui_access"`
+}
+
+func (r *NetworkInterfaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_interface"
+}
+
+func (r *NetworkInterfaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the network interface.")
+}
+
+func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the configuration of a network interface of the LoadMaster.\n\nInterfaces can not be created or removed, the resource takes over an existing interface. Destroying the resource only removes it from the state, the configuration on the LoadMaster is kept so the connection to the LoadMaster is not lost.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the interface, same as `interface_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface_id": schema.Int32Attribute{
+				MarkdownDescription: "The id of the interface, e.g. `0` for `eth0`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "The primary address of the interface in CIDR notation, e.g. `10.0.0.10/24`.",
+				Required:            true,
+			},
+			"mtu": schema.Int32Attribute{
+				MarkdownDescription: "The MTU of the interface.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"default_gateway": schema.BoolAttribute{
+				MarkdownDescription: "Whether the default gateway is reached through this interface.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"admin_wui_access": schema.BoolAttribute{
+				MarkdownDescription: "Whether the administrative web interface is reachable on this interface.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NetworkInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkInterfaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NetworkInterfaceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Address.IsNull() || data.Address.IsUnknown() {
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(data.Address.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("address"),
+			"Invalid Interface Address",
+			fmt.Sprintf("The address must be in CIDR notation, got: %s", data.Address.ValueString()),
+		)
+	}
+}
+
+func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkInterfaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "interface_id", data.InterfaceId)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ModifyInterface(strconv.Itoa(int(data.InterfaceId.ValueInt32())), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create network interface, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource network interface")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *NetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkInterfaceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ShowInterface(strconv.Itoa(int(data.InterfaceId.ValueInt32())))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isInterfaceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network interface, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data NetworkInterfaceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ModifyInterface(strconv.Itoa(int(data.InterfaceId.ValueInt32())), r.parameters(data))
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update network interface, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed network interface from state, the configuration on the LoadMaster is kept")
+}
+
+func (r *NetworkInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data NetworkInterfaceResourceModel
+
+	id := importId(ctx, req, &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.InterfaceResponse, error) {
+		return r.client.ShowInterface(id)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network interface for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *NetworkInterfaceResource) parameters(data NetworkInterfaceResourceModel) api.InterfaceParameters {
+	return api.InterfaceParameters{
+		Address:        data.Address.ValueString(),
+		MTU:            data.Mtu.ValueInt32Pointer(),
+		DefaultGateway: data.DefaultGateway.ValueBoolPointer(),
+		AdminWUIAccess: data.AdminWuiAccess.ValueBoolPointer(),
+	}
+}
+
+func (r *NetworkInterfaceResource) fromResponse(data *NetworkInterfaceResourceModel, response *api.InterfaceResponse) {
+	data.Id = types.StringValue(strconv.Itoa(int(response.Id)))
+	data.InterfaceId = types.Int32Value(response.Id)
+	data.Address = types.StringValue(response.Address)
+	data.Mtu = types.Int32Value(response.MTU)
+	data.DefaultGateway = types.BoolPointerValue(response.DefaultGateway)
+	data.AdminWuiAccess = types.BoolPointerValue(response.AdminWUIAccess)
+}
+
+func isInterfaceNotFoundError(err error) bool {
+	serr, ok := err.(*api.LoadMasterError)
+
+	return ok && serr.Message == "Unknown interface"
+}
+
+// interfaceContainsAddress reports whether the address lies within the
+// primary or one of the additional subnets of any of the interfaces.
+func interfaceContainsAddress(interfaces []api.InterfaceResponse, address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	for _, iface := range interfaces {
+		for _, cidr := range append([]string{iface.Address}, iface.AdditionalAddresses...) {
+			_, network, err := net.ParseCIDR(cidr)
+			if err != nil {
+				continue
+			}
+
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestNetworkInterfaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testNetworkInterfaceResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_network_interface.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("1"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_network_interface.test",
+						tfjsonpath.New("address"),
+						knownvalue.StringExact("10.1.0.10/24"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_network_interface.test",
+						tfjsonpath.New("mtu"),
+						knownvalue.Int32Exact(1500),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_network_interface.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestInterfaceContainsAddress(t *testing.T) {
+	interfaces := []api.InterfaceResponse{
+		{Id: 0, Address: "10.0.0.10/24"},
+		{Id: 1, Address: "10.1.0.10/24", AdditionalAddresses: []string{"10.2.0.10/24", "2001:db8::10/64"}},
+	}
+
+	tests := map[string]bool{
+		"10.0.0.4":       true,
+		"10.2.0.200":     true,
+		"2001:db8::1234": true,
+		"10.3.0.1":       false,
+		"not an address": false,
+	}
+
+	for address, expected := range tests {
+		if actual := interfaceContainsAddress(interfaces, address); actual != expected {
+			t.Errorf("interfaceContainsAddress(%q) = %t, expected %t", address, actual, expected)
+		}
+	}
+}
+
+const testNetworkInterfaceResourceConfig = `
+resource "loadmaster_network_interface" "test" {
+  interface_id = 1
+  address = "10.1.0.10/24"
+  mtu = 1500
+}
+`
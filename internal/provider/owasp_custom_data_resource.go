@@ -11,7 +11,9 @@ import (
 	"strings"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +24,9 @@ import (
 
 var _ resource.Resource = &OwaspCustomDataResource{}
 var _ resource.ResourceWithImportState = &OwaspCustomDataResource{}
+var _ resource.ResourceWithValidateConfig = &OwaspCustomDataResource{}
+var _ resource.ResourceWithModifyPlan = &OwaspCustomDataResource{}
+var _ resource.ResourceWithIdentity = &OwaspCustomDataResource{}
 
 func NewOwaspCustomDataResource() resource.Resource {
 	return &OwaspCustomDataResource{}
@@ -32,8 +37,10 @@ type OwaspCustomDataResource struct {
 }
 
 type OwaspCustomDataResourceModel struct {
-	Filename types.String `tfsdk:"filename"`
-	Data     types.String `tfsdk:"data"`
+	Filename   types.String `tfsdk:"filename"`
+	Data       types.String `tfsdk:"data"`
+	Source     types.String `tfsdk:"source"`
+	SourceHash types.String `tfsdk:"source_hash"`
 }
 
 func (r OwaspCustomDataResource) getMarker() string {
@@ -44,6 +51,17 @@ func (r *OwaspCustomDataResource) Metadata(ctx context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_owasp_custom_data"
 }
 
+func (r *OwaspCustomDataResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"filename": identityschema.StringAttribute{
+				Description:       "The file name of the data.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *OwaspCustomDataResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `OwaspCustomData`.\n\nBeware: The LoadMaster API base64 encodes the data and returns this format only if there exists a multibyte character. This resource places a marker line in every resource to ensure consistent behavior.",
@@ -58,8 +76,17 @@ func (r *OwaspCustomDataResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"data": schema.StringAttribute{
-				MarkdownDescription: "The content of the custom data.",
-				Required:            true,
+				MarkdownDescription: "The content of the custom data. Exactly one of `data` or `source` must be set.",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file with the content of the custom data. Exactly one of `data` or `source` must be set.",
+				Optional:            true,
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the content of `source`. Only this hash is stored in state, and it is compared with the hash of the content on the LoadMaster to detect changes. If set, it must match the hash of the content of `source`. A trailing `\\r\\n` of the content is not part of the hash, as the LoadMaster does not return it, so for such files it differs from `filesha256()`.",
+				Optional:            true,
+				Computed:            true,
 			},
 		},
 	}
@@ -83,6 +110,81 @@ func (r *OwaspCustomDataResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *OwaspCustomDataResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OwaspCustomDataResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOwaspSourceConfig(data.Data, data.Source, data.SourceHash)...)
+}
+
+func (r *OwaspCustomDataResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data OwaspCustomDataResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Source.IsUnknown() {
+		return
+	}
+
+	if data.Source.IsNull() {
+		data.SourceHash = types.StringNull()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	}
+
+	source, err := ReadOwaspSource(data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Source", fmt.Sprintf("Unable to read source %s, got error: %s", data.Source.ValueString(), err))
+		return
+	}
+
+	if source.Package {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Source", "Custom data can only be read from a single file. Use `loadmaster_owasp_custom_rule` to upload a rule package.")
+		return
+	}
+
+	var config OwaspCustomDataResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	hash := source.Hash()
+	if !config.SourceHash.IsNull() && !config.SourceHash.IsUnknown() && config.SourceHash.ValueString() != hash {
+		resp.Diagnostics.AddAttributeError(path.Root("source_hash"), "Source Hash Mismatch", fmt.Sprintf("The configured source hash %s does not match the hash %s of the content of %s.", config.SourceHash.ValueString(), hash, data.Source.ValueString()))
+		return
+	}
+
+	data.SourceHash = types.StringValue(hash)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// content returns the base64 encoded content to upload. This is either the
+// inline data, or the content read from source.
+func (r *OwaspCustomDataResource) content(data OwaspCustomDataResourceModel) (string, error) {
+	if data.Source.IsNull() {
+		return base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString())), nil
+	}
+
+	source, err := ReadOwaspSource(data.Source.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(append([]byte(r.getMarker()), source.Files[source.Names()[0]]...)), nil
+}
+
 func (r *OwaspCustomDataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data OwaspCustomDataResourceModel
 
@@ -94,8 +196,11 @@ func (r *OwaspCustomDataResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Debug(ctx, "creating a resource")
 
-	// Decoding shenanigans
-	content := base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString()))
+	content, err := r.content(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Source Error", fmt.Sprintf("Unable to read source of owasp custom data, got error: %s", err))
+		return
+	}
 
 	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
 		return r.client.AddOwaspCustomData(data.Filename.ValueString(), content)
@@ -110,11 +215,12 @@ func (r *OwaspCustomDataResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "Received valid response from API")
 
 	data.Filename = types.StringValue(data.Filename.ValueString())
-	data.Data = types.StringValue(data.Data.ValueString())
+	data.Data = types.StringPointerValue(data.Data.ValueStringPointer())
 
 	tflog.Trace(ctx, "created a resource owasp custom data")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
 
 func (r *OwaspCustomDataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -151,9 +257,14 @@ func (r *OwaspCustomDataResource) Read(ctx context.Context, req resource.ReadReq
 	content := strings.TrimSuffix(strings.TrimPrefix(string(content_bytes), r.getMarker()), "\r\n")
 
 	data.Filename = types.StringValue(data.Filename.ValueString())
-	data.Data = types.StringValue(content)
+	if data.Source.IsNull() {
+		data.Data = types.StringValue(content)
+	} else {
+		data.SourceHash = types.StringValue(OwaspContentHash(map[string][]byte{data.Filename.ValueString(): []byte(content)}, false))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
 
 func (r *OwaspCustomDataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -163,8 +274,11 @@ func (r *OwaspCustomDataResource) Update(ctx context.Context, req resource.Updat
 
 	tflog.Debug(ctx, "updating the resource")
 
-	// Decoding shenanigans
-	content := base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString()))
+	content, err := r.content(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Source Error", fmt.Sprintf("Unable to read source of owasp custom data, got error: %s", err))
+		return
+	}
 
 	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
 		return r.client.AddOwaspCustomData(data.Filename.ValueString(), content)
@@ -179,7 +293,7 @@ func (r *OwaspCustomDataResource) Update(ctx context.Context, req resource.Updat
 	tflog.Trace(ctx, "Received valid response from API")
 
 	data.Filename = types.StringValue(data.Filename.ValueString())
-	data.Data = types.StringValue(data.Data.ValueString())
+	data.Data = types.StringPointerValue(data.Data.ValueStringPointer())
 
 	tflog.Trace(ctx, "updated a resource owasp custom data")
 
@@ -210,8 +324,10 @@ func (r *OwaspCustomDataResource) Delete(ctx context.Context, req resource.Delet
 func (r *OwaspCustomDataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data OwaspCustomDataResourceModel
 
+	id := importAttribute(ctx, req, "filename", &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
-		return r.client.ShowOwaspCustomData(req.ID)
+		return r.client.ShowOwaspCustomData(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -225,8 +341,9 @@ func (r *OwaspCustomDataResource) ImportState(ctx context.Context, req resource.
 	tflog.SetField(ctx, "response", response)
 	tflog.Trace(ctx, "Received valid response from API")
 
-	data.Filename = types.StringValue(req.ID)
+	data.Filename = types.StringValue(id)
 	data.Data = types.StringValue(response.Data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
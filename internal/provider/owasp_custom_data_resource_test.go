@@ -192,6 +192,44 @@ func TestOwaspCustomDataResourceUpdate(t *testing.T) {
 	})
 }
 
+func TestOwaspCustomDataResourceSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "test_data_source.txt")
+	writeOwaspSourceTestFile(t, source, "10.0.0.0/8\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testOwaspCustomDataResourceSource(source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_data.test_data",
+						tfjsonpath.New("source_hash"),
+						knownvalue.StringExact(OwaspContentHash(map[string][]byte{"test_data_source.txt": []byte("10.0.0.0/8\n")}, false)),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_data.test_data",
+						tfjsonpath.New("data"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testOwaspCustomDataResourceSource(source string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_owasp_custom_data" "test_data" {
+  filename    = "test_data_source.txt"
+  source      = %q
+  source_hash = filesha256(%q)
+}
+`, source, source)
+}
+
 func testOwaspCustomDataResource() string {
 	return `
 resource "loadmaster_owasp_custom_data" "test_data" {
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ list.ListResource = &OwaspCustomListResource[OwaspCustomRuleResourceModel]{}
+var _ list.ListResourceWithConfigure = &OwaspCustomListResource[OwaspCustomRuleResourceModel]{}
+
+// OwaspCustomListResource lists the OWASP custom rules or data files.
+type OwaspCustomListResource[T any] struct {
+	client *api.Client
+
+	// name is the type name of the files, e.g. `owasp_custom_rule`.
+	name string
+	// description is the name of the files used in descriptions.
+	description string
+	// marker is the marker line the resource places in every file.
+	marker string
+	// files lists the file names.
+	files func(client *api.Client) (*api.ListOwaspCustomResponse, error)
+	// show reads the content of a file.
+	show func(client *api.Client, filename string) (*api.LoadMasterDataResponse, error)
+	// model converts a file to the model of its resource.
+	model func(filename string, content string) T
+}
+
+type OwaspCustomListResourceModel struct {
+	Filename types.String `tfsdk:"filename"`
+}
+
+func NewOwaspCustomRuleListResource() list.ListResource {
+	return &OwaspCustomListResource[OwaspCustomRuleResourceModel]{
+		name:        "owasp_custom_rule",
+		description: "OWASP custom rules",
+		marker:      OwaspCustomRuleResource{}.getMarker(),
+		files: func(client *api.Client) (*api.ListOwaspCustomResponse, error) {
+			return client.ListOwaspCustomRules()
+		},
+		show: func(client *api.Client, filename string) (*api.LoadMasterDataResponse, error) {
+			return client.ShowOwaspCustomRule(strings.TrimSuffix(filename, filepath.Ext(filename)))
+		},
+		model: func(filename string, content string) OwaspCustomRuleResourceModel {
+			return OwaspCustomRuleResourceModel{
+				Filename:    types.StringValue(filename),
+				Data:        types.StringValue(content),
+				SourceFiles: types.ListNull(types.StringType),
+			}
+		},
+	}
+}
+
+func NewOwaspCustomDataListResource() list.ListResource {
+	return &OwaspCustomListResource[OwaspCustomDataResourceModel]{
+		name:        "owasp_custom_data",
+		description: "OWASP custom data files",
+		marker:      OwaspCustomDataResource{}.getMarker(),
+		files: func(client *api.Client) (*api.ListOwaspCustomResponse, error) {
+			return client.ListOwaspCustomData()
+		},
+		show: func(client *api.Client, filename string) (*api.LoadMasterDataResponse, error) {
+			return client.ShowOwaspCustomData(filename)
+		},
+		model: func(filename string, content string) OwaspCustomDataResourceModel {
+			return OwaspCustomDataResourceModel{
+				Filename: types.StringValue(filename),
+				Data:     types.StringValue(content),
+			}
+		},
+	}
+}
+
+func (r *OwaspCustomListResource[T]) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.name
+}
+
+func (r *OwaspCustomListResource[T]) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists the %s of the LoadMaster.", r.description),
+
+		Attributes: map[string]schema.Attribute{
+			"filename": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the file name must match.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *OwaspCustomListResource[T]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OwaspCustomListResource[T]) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data OwaspCustomListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	filename, d := listFilterRegexp(data.Filename, "filename")
+	diags.Append(d...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListOwaspCustomResponse, error) {
+		return r.files(r.client)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %s, got error: %s", r.description, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, file := range response.Files {
+			if !filename.MatchString(file) {
+				continue
+			}
+
+			if listLimitReached(req, count) {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = file
+			result.Diagnostics.Append(result.Identity.Set(ctx, FilenameIdentityModel{Filename: types.StringValue(file)})...)
+
+			if req.IncludeResource {
+				content, err := r.content(ctx, file)
+				if err != nil {
+					result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", file, err))
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, r.model(file, content))...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// content reads a file and removes the encoding and the marker line, like the
+// resources do.
+func (r *OwaspCustomListResource[T]) content(ctx context.Context, file string) (string, error) {
+	operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
+		return r.show(r.client, file)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return "", err
+	}
+
+	content, err := base64.StdEncoding.DecodeString(response.Data)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(string(content), r.marker), "\r\n"), nil
+}
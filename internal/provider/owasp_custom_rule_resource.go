@@ -8,10 +8,14 @@ import (
 	"encoding/base64"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -22,6 +26,9 @@ import (
 
 var _ resource.Resource = &OwaspCustomRuleResource{}
 var _ resource.ResourceWithImportState = &OwaspCustomRuleResource{}
+var _ resource.ResourceWithValidateConfig = &OwaspCustomRuleResource{}
+var _ resource.ResourceWithModifyPlan = &OwaspCustomRuleResource{}
+var _ resource.ResourceWithIdentity = &OwaspCustomRuleResource{}
 
 func NewOwaspCustomRuleResource() resource.Resource {
 	return &OwaspCustomRuleResource{}
@@ -32,8 +39,11 @@ type OwaspCustomRuleResource struct {
 }
 
 type OwaspCustomRuleResourceModel struct {
-	Filename types.String `tfsdk:"filename"`
-	Data     types.String `tfsdk:"data"`
+	Filename    types.String `tfsdk:"filename"`
+	Data        types.String `tfsdk:"data"`
+	Source      types.String `tfsdk:"source"`
+	SourceHash  types.String `tfsdk:"source_hash"`
+	SourceFiles types.List   `tfsdk:"source_files"`
 }
 
 func (r OwaspCustomRuleResource) getMarker() string {
@@ -44,6 +54,17 @@ func (r *OwaspCustomRuleResource) Metadata(ctx context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_owasp_custom_rule"
 }
 
+func (r *OwaspCustomRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"filename": identityschema.StringAttribute{
+				Description:       "The file name of the rule.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *OwaspCustomRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `OwaspCustomRule`.\n\nBeware: The LoadMaster API base64 encodes the data and returns this format only if there exists a multibyte character. This resource places a marker line in every resource to ensure consistent behavior.",
@@ -58,8 +79,22 @@ func (r *OwaspCustomRuleResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"data": schema.StringAttribute{
-				MarkdownDescription: "The content of the custom rule.",
-				Required:            true,
+				MarkdownDescription: "The content of the custom rule. Exactly one of `data` or `source` must be set.",
+				Optional:            true,
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path to a local file with the content of the custom rule. Can also be a directory or a `.tar.gz` archive containing `.conf` and `.data` files, which are uploaded as one rule package. The `filename` must end with `.tar.gz` if and only if `source` is a rule package. Exactly one of `data` or `source` must be set.",
+				Optional:            true,
+			},
+			"source_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the content of `source`. Only this hash is stored in state, and it is compared with the hash of the content on the LoadMaster to detect changes. If set, it must match the hash of the content of `source`. A trailing `\\r\\n` of the content is not part of the hash, as the LoadMaster does not return it, so for such files it differs from `filesha256()`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"source_files": schema.ListAttribute{
+				MarkdownDescription: "Names of the files uploaded from `source`.",
+				ElementType:         types.StringType,
+				Computed:            true,
 			},
 		},
 	}
@@ -83,6 +118,167 @@ func (r *OwaspCustomRuleResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+func (r *OwaspCustomRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data OwaspCustomRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOwaspSourceConfig(data.Data, data.Source, data.SourceHash)...)
+}
+
+func (r *OwaspCustomRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data OwaspCustomRuleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Source.IsUnknown() {
+		return
+	}
+
+	if data.Source.IsNull() {
+		data.SourceHash = types.StringNull()
+		data.SourceFiles = types.ListNull(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+		return
+	}
+
+	source, err := ReadOwaspSource(data.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Source", fmt.Sprintf("Unable to read source %s, got error: %s", data.Source.ValueString(), err))
+		return
+	}
+
+	if !data.Filename.IsUnknown() && source.Package != strings.HasSuffix(data.Filename.ValueString(), ".tar.gz") {
+		resp.Diagnostics.AddAttributeError(path.Root("filename"), "Invalid Filename", "The filename must end with `.tar.gz` if and only if the source is a rule package.")
+		return
+	}
+
+	var config OwaspCustomRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	hash := source.Hash()
+	if !config.SourceHash.IsNull() && !config.SourceHash.IsUnknown() && config.SourceHash.ValueString() != hash {
+		resp.Diagnostics.AddAttributeError(path.Root("source_hash"), "Source Hash Mismatch", fmt.Sprintf("The configured source hash %s does not match the hash %s of the content of %s.", config.SourceHash.ValueString(), hash, data.Source.ValueString()))
+		return
+	}
+
+	files, diags := types.ListValueFrom(ctx, types.StringType, source.Names())
+	resp.Diagnostics.Append(diags...)
+	data.SourceHash = types.StringValue(hash)
+	data.SourceFiles = files
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
+// content returns the base64 encoded content to upload for the rule. This is
+// either the inline data, or the content read from source.
+func (r *OwaspCustomRuleResource) content(data OwaspCustomRuleResourceModel) (string, error) {
+	if data.Source.IsNull() {
+		return base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString())), nil
+	}
+
+	source, err := ReadOwaspSource(data.Source.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	if !source.Package {
+		return base64.StdEncoding.EncodeToString(append([]byte(r.getMarker()), source.Files[source.Names()[0]]...)), nil
+	}
+
+	archive, err := source.Archive(r.getMarker())
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(archive), nil
+}
+
+// isPackage reports whether the rule was uploaded as a rule package. This is
+// decided by the filename, the local source may no longer exist.
+func (r *OwaspCustomRuleResource) isPackage(data OwaspCustomRuleResourceModel) bool {
+	return !data.Source.IsNull() && strings.HasSuffix(data.Filename.ValueString(), ".tar.gz")
+}
+
+// remoteFilenames returns the names of the files of the rule on the
+// LoadMaster, which are the files of the package for a rule package.
+func (r *OwaspCustomRuleResource) remoteFilenames(ctx context.Context, data OwaspCustomRuleResourceModel) ([]string, diag.Diagnostics) {
+	if !r.isPackage(data) {
+		return []string{data.Filename.ValueString()}, nil
+	}
+
+	filenames := []string{}
+	diags := data.SourceFiles.ElementsAs(ctx, &filenames, false)
+
+	return filenames, diags
+}
+
+// deleteFiles deletes the files of a rule from the LoadMaster.
+func (r *OwaspCustomRuleResource) deleteFiles(ctx context.Context, filenames []string) error {
+	for _, name := range filenames {
+		filename := strings.TrimSuffix(name, filepath.Ext(name))
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			if filepath.Ext(name) == ".data" {
+				return r.client.DeleteOwaspCustomData(filename)
+			}
+			return r.client.DeleteOwaspCustomRule(filename)
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readRemoteSourceFiles reads the content of the files uploaded from source
+// back from the LoadMaster. Files which do not exist anymore are omitted.
+func (r *OwaspCustomRuleResource) readRemoteSourceFiles(ctx context.Context, data OwaspCustomRuleResourceModel) (map[string][]byte, error) {
+	filenames, diags := r.remoteFilenames(ctx, data)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to read source files from state")
+	}
+
+	files := map[string][]byte{}
+	for _, name := range filenames {
+		operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
+			if filepath.Ext(name) == ".data" {
+				return r.client.ShowOwaspCustomData(name)
+			}
+			return r.client.ShowOwaspCustomRule(strings.TrimSuffix(name, filepath.Ext(name)))
+		})
+		response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			if serr, ok := err.(*api.LoadMasterError); ok && serr.Code == 404 {
+				continue
+			}
+			return nil, err
+		}
+
+		content, err := DecodeOwaspContent(response.Data, r.getMarker())
+		if err != nil {
+			return nil, err
+		}
+		files[name] = []byte(content)
+	}
+
+	return files, nil
+}
+
 func (r *OwaspCustomRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data OwaspCustomRuleResourceModel
 
@@ -94,7 +290,11 @@ func (r *OwaspCustomRuleResource) Create(ctx context.Context, req resource.Creat
 
 	tflog.Debug(ctx, "creating a resource")
 
-	content := base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString()))
+	content, err := r.content(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Source Error", fmt.Sprintf("Unable to read source of owasp custom rule, got error: %s", err))
+		return
+	}
 
 	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
 		return r.client.AddOwaspCustomRule(data.Filename.ValueString(), content)
@@ -109,11 +309,12 @@ func (r *OwaspCustomRuleResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "Received valid response from API")
 
 	data.Filename = types.StringValue(data.Filename.ValueString())
-	data.Data = types.StringValue(data.Data.ValueString())
+	data.Data = types.StringPointerValue(data.Data.ValueStringPointer())
 
 	tflog.Trace(ctx, "created a resource owasp custom rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
 
 func (r *OwaspCustomRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -125,6 +326,25 @@ func (r *OwaspCustomRuleResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	if !data.Source.IsNull() {
+		files, err := r.readRemoteSourceFiles(ctx, data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read owasp custom rule, got error: %s", err))
+			return
+		}
+
+		if len(files) == 0 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		data.SourceHash = types.StringValue(OwaspContentHash(files, r.isPackage(data)))
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
+		return
+	}
+
 	filename := strings.TrimSuffix(data.Filename.ValueString(), filepath.Ext(data.Filename.ValueString()))
 
 	operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
@@ -155,16 +375,26 @@ func (r *OwaspCustomRuleResource) Read(ctx context.Context, req resource.ReadReq
 	data.Data = types.StringValue(content)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
 
 func (r *OwaspCustomRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data OwaspCustomRuleResourceModel
+	var data, state OwaspCustomRuleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Debug(ctx, "updating the resource")
 
-	content := base64.StdEncoding.EncodeToString([]byte(r.getMarker() + data.Data.ValueString()))
+	content, err := r.content(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Source Error", fmt.Sprintf("Unable to read source of owasp custom rule, got error: %s", err))
+		return
+	}
 
 	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
 		return r.client.AddOwaspCustomRule(data.Filename.ValueString(), content)
@@ -178,8 +408,27 @@ func (r *OwaspCustomRuleResource) Update(ctx context.Context, req resource.Updat
 	tflog.SetField(ctx, "response", response)
 	tflog.Trace(ctx, "Received valid response from API")
 
+	// Files which were part of the previous package but are not uploaded
+	// anymore would otherwise remain on the LoadMaster.
+	previous, diags := r.remoteFilenames(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	current, diags := r.remoteFilenames(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removed := slices.DeleteFunc(previous, func(name string) bool {
+		return slices.Contains(current, name)
+	})
+	if err := r.deleteFiles(ctx, removed); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete files removed from owasp custom rule, got error: %s", err))
+		return
+	}
+
 	data.Filename = types.StringValue(data.Filename.ValueString())
-	data.Data = types.StringValue(data.Data.ValueString())
+	data.Data = types.StringPointerValue(data.Data.ValueStringPointer())
 
 	tflog.Trace(ctx, "updated a resource owasp custom rule")
 
@@ -195,13 +444,14 @@ func (r *OwaspCustomRuleResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	filename := strings.TrimSuffix(data.Filename.ValueString(), filepath.Ext(data.Filename.ValueString()))
+	filenames, diags := r.remoteFilenames(ctx, data)
+	resp.Diagnostics.Append(diags...)
 
-	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
-		return r.client.DeleteOwaspCustomRule(filename)
-	})
-	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
-	if err != nil {
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.deleteFiles(ctx, filenames); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rule, got error: %s", err))
 		return
 	}
@@ -210,7 +460,9 @@ func (r *OwaspCustomRuleResource) Delete(ctx context.Context, req resource.Delet
 func (r *OwaspCustomRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data OwaspCustomRuleResourceModel
 
-	filename := strings.TrimSuffix(req.ID, filepath.Ext(req.ID))
+	id := importAttribute(ctx, req, "filename", &resp.Diagnostics)
+
+	filename := strings.TrimSuffix(id, filepath.Ext(id))
 
 	operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
 		return r.client.ShowOwaspCustomRule(filename)
@@ -227,8 +479,9 @@ func (r *OwaspCustomRuleResource) ImportState(ctx context.Context, req resource.
 	tflog.SetField(ctx, "response", response)
 	tflog.Trace(ctx, "Received valid response from API")
 
-	data.Filename = types.StringValue(req.ID)
+	data.Filename = types.StringValue(id)
 	data.Data = types.StringValue(response.Data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, FilenameIdentityModel{Filename: data.Filename})...)
 }
@@ -12,9 +12,11 @@ import (
 	"testing"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/kreemer/loadmaster-go-client/api"
 )
@@ -217,6 +219,174 @@ func TestOwaspCustomRuleResourceReal2(t *testing.T) {
 	})
 }
 
+func TestOwaspCustomRuleResourceSource(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "test_rule_source.conf")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					writeOwaspSourceTestFile(t, source, "SecMarker BEGIN_SOURCE_1\n")
+				},
+				Config: testOwaspCustomRuleResourceSource(source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_rule",
+						tfjsonpath.New("source_hash"),
+						knownvalue.StringExact(OwaspContentHash(map[string][]byte{"test_rule_source.conf": []byte("SecMarker BEGIN_SOURCE_1\n")}, false)),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_rule",
+						tfjsonpath.New("data"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				PreConfig: func() {
+					writeOwaspSourceTestFile(t, source, "SecMarker BEGIN_SOURCE_2\n")
+				},
+				Config: testOwaspCustomRuleResourceSource(source),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_rule",
+						tfjsonpath.New("source_hash"),
+						knownvalue.StringExact(OwaspContentHash(map[string][]byte{"test_rule_source.conf": []byte("SecMarker BEGIN_SOURCE_2\n")}, false)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestOwaspCustomRuleResourceSourcePackage(t *testing.T) {
+	dir := t.TempDir()
+	writeOwaspSourceTestFile(t, filepath.Join(dir, "test_package.conf"), "SecRule REMOTE_ADDR \"!@ipMatchFromFile test_package.data\" \"id:12000,phase:1,deny\"\n")
+	writeOwaspSourceTestFile(t, filepath.Join(dir, "test_package.data"), "10.0.0.0/8\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testOwaspCustomRuleResourceSourcePackage(dir),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_package",
+						tfjsonpath.New("source_files"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("test_package.conf"),
+							knownvalue.StringExact("test_package.data"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_package",
+						tfjsonpath.New("source_hash"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Files removed from the package are deleted on the LoadMaster
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(dir, "test_package.data")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testOwaspCustomRuleResourceSourcePackage(dir),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_owasp_custom_rule.test_package",
+						tfjsonpath.New("source_files"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("test_package.conf"),
+						}),
+					),
+				},
+				Check: testCheckNoOwaspCustomData(t, "test_package.data"),
+			},
+		},
+	})
+}
+
+func TestOwaspCustomRuleIsPackage(t *testing.T) {
+	var r OwaspCustomRuleResource
+
+	// The source no longer exists, the state decides.
+	source := filepath.Join(t.TempDir(), "missing")
+
+	tests := map[string]struct {
+		data     OwaspCustomRuleResourceModel
+		expected bool
+	}{
+		"package": {
+			data:     OwaspCustomRuleResourceModel{Filename: types.StringValue("rules.tar.gz"), Source: types.StringValue(source)},
+			expected: true,
+		},
+		"file": {
+			data: OwaspCustomRuleResourceModel{Filename: types.StringValue("rule.conf"), Source: types.StringValue(source)},
+		},
+		"data": {
+			data: OwaspCustomRuleResourceModel{Filename: types.StringValue("rule.conf"), Source: types.StringNull()},
+		},
+	}
+
+	for name, test := range tests {
+		if r.isPackage(test.data) != test.expected {
+			t.Errorf("%s: expected isPackage to be %t", name, test.expected)
+		}
+	}
+}
+
+func testCheckNoOwaspCustomData(t *testing.T, filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := api.NewClientWithApiKey(os.Getenv("LOADMASTER_HOST"), os.Getenv("LOADMASTER_API_KEY"))
+
+		operation := ClientBackoff(func() (*api.LoadMasterDataResponse, error) {
+			return client.ShowOwaspCustomData(filename)
+		})
+		_, err := backoff.Retry(t.Context(), operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Code == 404 {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("expected owasp custom data %s to be deleted", filename)
+	}
+}
+
+func writeOwaspSourceTestFile(t *testing.T, name string, content string) {
+	err := os.WriteFile(name, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testOwaspCustomRuleResourceSource(source string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_owasp_custom_rule" "test_rule" {
+  filename = "test_rule_source.conf"
+  source   = %q
+}
+`, source)
+}
+
+func testOwaspCustomRuleResourceSourcePackage(source string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_owasp_custom_rule" "test_package" {
+  filename = "test_package.tar.gz"
+  source   = %q
+}
+`, source)
+}
+
 func testOwaspCustomRuleResource() string {
 	return `
 resource "loadmaster_owasp_custom_rule" "test_rule" {
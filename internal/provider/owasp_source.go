@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// OwaspSource holds the files read from the `source` attribute of an OWASP
+// custom rule or data resource. A source is either a single file, or a rule
+// package consisting of `.conf` and `.data` files given as a directory or as a
+// `.tar.gz` archive.
+type OwaspSource struct {
+	Files   map[string][]byte
+	Package bool
+}
+
+func isOwaspPackageArchive(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func isOwaspPackageMember(name string) bool {
+	ext := filepath.Ext(name)
+	return ext == ".conf" || ext == ".data"
+}
+
+// ReadOwaspSource reads the local file, directory or archive at path.
+func ReadOwaspSource(path string) (*OwaspSource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return readOwaspSourceDirectory(path)
+	}
+
+	if isOwaspPackageArchive(path) {
+		return readOwaspSourceArchive(path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OwaspSource{
+		Files: map[string][]byte{filepath.Base(path): content},
+	}, nil
+}
+
+func readOwaspSourceDirectory(path string) (*OwaspSource, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &OwaspSource{Files: map[string][]byte{}, Package: true}
+	for _, entry := range entries {
+		if entry.IsDir() || !isOwaspPackageMember(entry.Name()) {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		source.Files[entry.Name()] = content
+	}
+
+	if len(source.Files) == 0 {
+		return nil, fmt.Errorf("directory %s does not contain any .conf or .data files", path)
+	}
+
+	return source, nil
+}
+
+func readOwaspSourceArchive(path string) (*OwaspSource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	source := &OwaspSource{Files: map[string][]byte{}, Package: true}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(header.Name)
+		if header.Typeflag != tar.TypeReg || !isOwaspPackageMember(name) {
+			continue
+		}
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		source.Files[name] = content
+	}
+
+	if len(source.Files) == 0 {
+		return nil, fmt.Errorf("archive %s does not contain any .conf or .data files", path)
+	}
+
+	return source, nil
+}
+
+// Names returns the sorted names of all files in the source.
+func (s *OwaspSource) Names() []string {
+	return sortedOwaspNames(s.Files)
+}
+
+func sortedOwaspNames(files map[string][]byte) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Hash returns the SHA256 hex digest of the source. A single file hashes to
+// the digest of its content, a package hashes to the digest of a `sha256sum`
+// style manifest of its files. A trailing line break of a file is not part of
+// its digest, as the LoadMaster does not return it.
+func (s *OwaspSource) Hash() string {
+	return OwaspContentHash(s.Files, s.Package)
+}
+
+// OwaspContentHash hashes the given file contents the same way as Hash.
+func OwaspContentHash(files map[string][]byte, pkg bool) string {
+	if !pkg && len(files) == 1 {
+		for _, content := range files {
+			sum := sha256.Sum256(normalizeOwaspContent(content))
+			return hex.EncodeToString(sum[:])
+		}
+	}
+
+	var manifest strings.Builder
+	for _, name := range sortedOwaspNames(files) {
+		sum := sha256.Sum256(normalizeOwaspContent(files[name]))
+		manifest.WriteString(hex.EncodeToString(sum[:]) + "  " + name + "\n")
+	}
+	sum := sha256.Sum256([]byte(manifest.String()))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Archive packs the files of the source into a `.tar.gz` archive, prefixing
+// every file with marker.
+func (s *OwaspSource) Archive(marker string) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	gz := gzip.NewWriter(&buffer)
+	writer := tar.NewWriter(gz)
+	for _, name := range s.Names() {
+		content := append([]byte(marker), s.Files[name]...)
+		err := writer.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(content)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := writer.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// DecodeOwaspContent decodes the base64 content returned by the LoadMaster API
+// and strips the marker and the line break appended by the appliance.
+func DecodeOwaspContent(data string, marker string) (string, error) {
+	content, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(string(content), marker), "\r\n"), nil
+}
+
+// normalizeOwaspContent strips the line break which the LoadMaster does not
+// return, so local and uploaded content hash the same.
+func normalizeOwaspContent(content []byte) []byte {
+	return bytes.TrimSuffix(content, []byte("\r\n"))
+}
+
+// validateOwaspSourceConfig ensures exactly one of data and source is set.
+func validateOwaspSourceConfig(data types.String, source types.String, sourceHash types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.IsUnknown() || source.IsUnknown() {
+		return diags
+	}
+
+	if data.IsNull() == source.IsNull() {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Invalid Attribute Combination",
+			"Exactly one of `data` or `source` must be set.",
+		)
+	}
+
+	if source.IsNull() && !sourceHash.IsNull() {
+		diags.AddAttributeError(
+			path.Root("source_hash"),
+			"Invalid Attribute Combination",
+			"`source_hash` can only be set together with `source`.",
+		)
+	}
+
+	return diags
+}
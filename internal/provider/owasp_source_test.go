@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadOwaspSourceFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "rule.conf")
+	writeOwaspSourceTestFile(t, name, "SecMarker TEST\n")
+
+	source, err := ReadOwaspSource(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if source.Package {
+		t.Errorf("expected a single file, got a package")
+	}
+
+	expected := OwaspContentHash(map[string][]byte{"other.conf": []byte("SecMarker TEST\n")}, false)
+	if source.Hash() != expected {
+		t.Errorf("expected hash %s, got %s", expected, source.Hash())
+	}
+}
+
+func TestReadOwaspSourcePackage(t *testing.T) {
+	dir := t.TempDir()
+	writeOwaspSourceTestFile(t, filepath.Join(dir, "rule.conf"), "SecMarker TEST\n")
+	writeOwaspSourceTestFile(t, filepath.Join(dir, "rule.data"), "10.0.0.0/8\n")
+	writeOwaspSourceTestFile(t, filepath.Join(dir, "README.md"), "ignored")
+
+	directory, err := ReadOwaspSource(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !directory.Package {
+		t.Errorf("expected a package, got a single file")
+	}
+
+	names := directory.Names()
+	if len(names) != 2 || names[0] != "rule.conf" || names[1] != "rule.data" {
+		t.Errorf("unexpected package files: %v", names)
+	}
+
+	archive, err := directory.Archive("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := filepath.Join(t.TempDir(), "rule.tar.gz")
+	if err := os.WriteFile(name, archive, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	packed, err := ReadOwaspSource(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if packed.Hash() != directory.Hash() {
+		t.Errorf("expected archive hash %s to match directory hash %s", packed.Hash(), directory.Hash())
+	}
+}
+
+func TestReadOwaspSourceEmptyDirectory(t *testing.T) {
+	_, err := ReadOwaspSource(t.TempDir())
+	if err == nil {
+		t.Errorf("expected an error for a directory without rule files")
+	}
+}
+
+func TestOwaspContentHashTrailingLineBreak(t *testing.T) {
+	local := OwaspContentHash(map[string][]byte{"rule.conf": []byte("SecMarker TEST\r\n")}, false)
+	remote := OwaspContentHash(map[string][]byte{"rule.conf": []byte("SecMarker TEST")}, false)
+
+	if local != remote {
+		t.Errorf("expected hash %s of the uploaded content to match hash %s of the local file", remote, local)
+	}
+}
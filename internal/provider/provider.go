@@ -5,17 +5,25 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"net/url"
 	"os"
+	"strings"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kreemer/loadmaster-go-client/api"
 )
 
@@ -24,6 +32,7 @@ var _ provider.Provider = &LoadMasterProvider{}
 var _ provider.ProviderWithFunctions = &LoadMasterProvider{}
 var _ provider.ProviderWithEphemeralResources = &LoadMasterProvider{}
 var _ provider.ProviderWithActions = &LoadMasterProvider{}
+var _ provider.ProviderWithListResources = &LoadMasterProvider{}
 
 // ScaffoldingProvider defines the provider implementation.
 type LoadMasterProvider struct {
@@ -31,6 +40,10 @@ type LoadMasterProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// failCreateStep is handed to the resources which roll back failed
+	// creates, acceptance tests set it to inject failures.
+	failCreateStep createFault
 }
 
 // ScaffoldingProviderModel describes the provider data model.
@@ -39,6 +52,9 @@ type LoadMasterProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	ApiKey   types.String `tfsdk:"api_key"`
+
+	OnStandby           types.String `tfsdk:"on_standby"`
+	FollowSharedAddress types.Bool   `tfsdk:"follow_shared_address"`
 }
 
 func (p *LoadMasterProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -68,6 +84,18 @@ func (p *LoadMasterProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"on_standby": schema.StringAttribute{
+				MarkdownDescription: "What to do when the LoadMaster is the standby unit of a high availability pair, changes on the standby unit are overwritten by the active unit. " +
+					"Either `warn` or `error`. Defaults to `warn`.",
+				Optional:   true,
+				Validators: oneOf("warn", "error"),
+			},
+			"follow_shared_address": schema.BoolAttribute{
+				MarkdownDescription: "Connect to the shared address of the high availability pair when the LoadMaster is the standby unit. " +
+					"The unit is checked when the provider is configured, and again when a request fails with a connection error during an apply. " +
+					"If the unit failed over by then, the remaining requests go to the shared address. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -169,21 +197,35 @@ func (p *LoadMasterProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	var client *api.Client
-	if apiKey != "" {
-		client = api.NewClientWithApiKey(host, apiKey)
-	} else {
-		client = api.NewClientWithUsernamePassword(host, username, password)
+	newClient := func(host string) *api.Client {
+		var client *api.Client
+		if apiKey != "" {
+			client = api.NewClientWithApiKey(host, apiKey)
+		} else {
+			client = api.NewClientWithUsernamePassword(host, username, password)
+		}
+
+		clientHosts.Store(client, host)
+		return client
 	}
+
+	client := configureHighAvailability(ctx, newClient(host), host, data, newClient, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 	resp.ActionData = client
+	resp.ListResourceData = client
 }
 
 func (p *LoadMasterProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewVirtualServiceResource,
-		NewSubVirtualServiceResource,
+		func() resource.Resource {
+			return &SubVirtualServiceResource{failCreateStep: p.failCreateStep}
+		},
 		NewRealServerResource,
 		NewMatchContentRuleResource,
 		NewAddHeaderRuleResource,
@@ -194,6 +236,32 @@ func (p *LoadMasterProvider) Resources(ctx context.Context) []func() resource.Re
 		NewOwaspCustomRuleResource,
 		NewOwaspCustomDataResource,
 		NewVirtualServiceOwaspRuleResource,
+		NewAccessListResource,
+		NewVirtualServiceAccessListResource,
+		NewGeoFilterResource,
+		NewVirtualServiceGeoFilterResource,
+		NewGeoFqdnResource,
+		NewGeoFqdnAddressResource,
+		NewGeoClusterResource,
+		NewGeoSettingsResource,
+		NewNetworkInterfaceResource,
+		NewInterfaceAddressResource,
+		NewVlanResource,
+		NewRouteResource,
+		NewRouteTableResource,
+		NewDefaultGatewayResource,
+		NewSystemSettingsResource,
+		NewSyslogResource,
+		NewSnmpResource,
+		NewEmailNotificationResource,
+		NewUserResource,
+		NewUserApiKeyResource,
+		NewLdapEndpointResource,
+		NewRadiusSettingsResource,
+		NewAdminAuthPolicyResource,
+		NewSSODomainResource,
+		NewBackupScheduleResource,
+		NewHASettingsResource,
 	}
 }
 
@@ -206,6 +274,8 @@ func (p *LoadMasterProvider) DataSources(ctx context.Context) []func() datasourc
 		NewVirtualServiceDataSource,
 		NewSubVirtualServiceDataSource,
 		NewRealServerDataSource,
+		NewVirtualServicesDataSource,
+		NewRealServersDataSource,
 		NewMatchContentRuleDataSource,
 		NewAddHeaderRuleDataSource,
 		NewDeleteHeaderRuleDataSource,
@@ -214,6 +284,25 @@ func (p *LoadMasterProvider) DataSources(ctx context.Context) []func() datasourc
 		NewReplaceBodyRuleDataSource,
 		NewOwaspCustomRuleDataSource,
 		NewOwaspCustomDataDataSource,
+		NewGeoFilterFeedDataSource,
+		NewBackupDataSource,
+		NewLicenseDataSource,
+	}
+}
+
+func (p *LoadMasterProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewVirtualServiceListResource,
+		NewSubVirtualServiceListResource,
+		NewRealServerListResource,
+		NewMatchContentRuleListResource,
+		NewAddHeaderRuleListResource,
+		NewDeleteHeaderRuleListResource,
+		NewReplaceHeaderRuleListResource,
+		NewModifyUrlRuleListResource,
+		NewReplaceBodyRuleListResource,
+		NewOwaspCustomRuleListResource,
+		NewOwaspCustomDataListResource,
 	}
 }
 
@@ -224,7 +313,113 @@ func (p *LoadMasterProvider) Functions(ctx context.Context) []func() function.Fu
 func (p *LoadMasterProvider) Actions(ctx context.Context) []func() action.Action {
 	return []func() action.Action{
 		NewVirtualServiceRestartAction,
+		NewBackupAction,
+		NewRestoreAction,
+		NewFirmwareUpgradeAction,
+		NewRebootAction,
+		NewLicenseActivateAction,
+	}
+}
+
+// configureHighAvailability checks whether the LoadMaster is the standby unit
+// of a high availability pair. Depending on the provider configuration the
+// shared address is used instead, or a warning or error is added. Failures to
+// read the status are only logged, the LoadMaster may not support it.
+func configureHighAvailability(ctx context.Context, client *api.Client, host string, data LoadMasterProviderModel, newClient func(string) *api.Client, diags *diag.Diagnostics) *api.Client {
+	response, err := showHighAvailability(ctx, client)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read high availability status", map[string]any{"error": err.Error()})
+		return client
+	}
+
+	if response.Mode == "single" {
+		return client
+	}
+
+	if response.Status != "standby" {
+		// The active unit may fail over during the apply, unless the shared
+		// address is already used.
+		if data.FollowSharedAddress.ValueBool() && response.SharedAddress != "" && hostAddress(host) != response.SharedAddress {
+			followFailover(client, sharedAddressHost(host, response.SharedAddress), newClient)
+		}
+
+		return client
+	}
+
+	if data.FollowSharedAddress.ValueBool() && response.SharedAddress != "" {
+		shared := sharedAddressHost(host, response.SharedAddress)
+		sharedClient := newClient(shared)
+
+		sharedResponse, err := showHighAvailability(ctx, sharedClient)
+		if err == nil && sharedResponse.Status == "active" {
+			diags.AddWarning(
+				"LoadMaster Is Standby",
+				fmt.Sprintf("The LoadMaster %s is the standby unit, the provider connects to the shared address %s instead.", host, shared),
+			)
+			return sharedClient
+		}
+
+		tflog.Warn(ctx, "Unable to reach the active unit on the shared address", map[string]any{"host": shared})
+	}
+
+	summary := "LoadMaster Is Standby"
+	detail := fmt.Sprintf("The LoadMaster %s is the standby unit of a high availability pair, changes are overwritten by the active unit. "+
+		"Configure the shared address %s as host or set `follow_shared_address`.", host, response.SharedAddress)
+
+	if data.OnStandby.ValueString() == "error" {
+		diags.AddError(summary, detail)
+	} else {
+		diags.AddWarning(summary, detail)
+	}
+
+	return client
+}
+
+// showHighAvailability reads the high availability status of the LoadMaster.
+// Tests replace it to simulate the units of a pair, such tests must not run
+// in parallel.
+var showHighAvailability = func(ctx context.Context, client *api.Client) (*api.HAResponse, error) {
+	operation := ClientBackoff(func() (*api.HAResponse, error) {
+		return client.ShowHA()
+	})
+
+	return backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+}
+
+// sharedAddressHost replaces the address in the configured host with the
+// shared address, keeping the scheme and port.
+func sharedAddressHost(host string, address string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		switch {
+		case u.Port() != "":
+			u.Host = net.JoinHostPort(address, u.Port())
+		case strings.Contains(address, ":"):
+			u.Host = "[" + address + "]"
+		default:
+			u.Host = address
+		}
+		return u.String()
+	}
+
+	if _, port, err := net.SplitHostPort(host); err == nil {
+		return net.JoinHostPort(address, port)
 	}
+
+	return address
+}
+
+// hostAddress returns the address or name in the configured host, without
+// the scheme and port.
+func hostAddress(host string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		return u.Hostname()
+	}
+
+	if address, _, err := net.SplitHostPort(host); err == nil {
+		return address
+	}
+
+	return host
 }
 
 func New(version string) func() provider.Provider {
@@ -238,3 +433,49 @@ func New(version string) func() provider.Provider {
 func bool2ptr(b bool) *bool {
 	return &b
 }
+
+// stringSetValues returns the elements of the set, or nil if the set is not
+// configured so the LoadMaster keeps its current values.
+func stringSetValues(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+
+	values := []string{}
+	diags := set.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// stringSetValue converts the values returned by the LoadMaster into a set,
+// an omitted list is treated as empty.
+func stringSetValue(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// stringListValues returns the elements of the list, or nil if the list is
+// not configured so the LoadMaster keeps its current values.
+func stringListValues(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	values := []string{}
+	diags := list.ElementsAs(ctx, &values, false)
+
+	return values, diags
+}
+
+// stringListValue converts the values returned by the LoadMaster into a list,
+// an omitted list is treated as empty.
+func stringListValue(ctx context.Context, values []string) (types.List, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
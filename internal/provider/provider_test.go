@@ -4,10 +4,16 @@
 package provider
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/kreemer/loadmaster-go-client/api"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -17,6 +23,21 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"loadmaster": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccProtoV6ProviderFactoriesFailingCreateSteps returns provider
+// factories whose resources fail in the named create steps.
+func testAccProtoV6ProviderFactoriesFailingCreateSteps(steps ...string) map[string]func() (tfprotov6.ProviderServer, error) {
+	fault := func(step string) error {
+		if slices.Contains(steps, step) {
+			return errors.New("injected failure")
+		}
+		return nil
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"loadmaster": providerserver.NewProtocol6WithError(&LoadMasterProvider{version: "test", failCreateStep: fault}),
+	}
+}
+
 // testAccProtoV6ProviderFactoriesWithEcho includes the echo provider alongside the scaffolding provider.
 // It allows for testing assertions on data returned by an ephemeral resource during Open.
 // The echoprovider is used to arrange tests by echoing ephemeral data into the Terraform state.
@@ -29,3 +50,219 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 func testAccPreCheck(t *testing.T) {
 
 }
+
+func TestSharedAddressHost(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{"https://10.0.0.1", "https://10.0.0.10"},
+		{"https://10.0.0.1:8443", "https://10.0.0.10:8443"},
+		{"https://lb01.example.com/", "https://10.0.0.10/"},
+		{"10.0.0.1:8443", "10.0.0.10:8443"},
+		{"10.0.0.1", "10.0.0.10"},
+	}
+
+	for _, test := range tests {
+		if actual := sharedAddressHost(test.host, "10.0.0.10"); actual != test.expected {
+			t.Errorf("sharedAddressHost(%q): expected %s, got %s", test.host, test.expected, actual)
+		}
+	}
+
+	if actual := sharedAddressHost("https://[fd00::1]:8443", "fd00::10"); actual != "https://[fd00::10]:8443" {
+		t.Errorf("expected https://[fd00::10]:8443, got %s", actual)
+	}
+}
+
+func TestHostAddress(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{"https://10.0.0.1", "10.0.0.1"},
+		{"https://lb01.example.com:8443/", "lb01.example.com"},
+		{"https://[fd00::1]:8443", "fd00::1"},
+		{"10.0.0.1:8443", "10.0.0.1"},
+		{"10.0.0.1", "10.0.0.1"},
+	}
+
+	for _, test := range tests {
+		if actual := hostAddress(test.host); actual != test.expected {
+			t.Errorf("hostAddress(%q): expected %s, got %s", test.host, test.expected, actual)
+		}
+	}
+}
+
+func TestConfigureHighAvailability(t *testing.T) {
+	tests := map[string]struct {
+		status   map[string]*api.HAResponse
+		data     LoadMasterProviderModel
+		host     string
+		warnings int
+		errors   int
+	}{
+		"single": {
+			status: map[string]*api.HAResponse{"10.0.0.1": {Mode: "single"}},
+			host:   "10.0.0.1",
+		},
+		"active": {
+			status: map[string]*api.HAResponse{"10.0.0.1": {Mode: "ha", Status: "active", SharedAddress: "10.0.0.10"}},
+			host:   "10.0.0.1",
+		},
+		"unavailable": {
+			status: map[string]*api.HAResponse{},
+			host:   "10.0.0.1",
+		},
+		"standby": {
+			status:   map[string]*api.HAResponse{"10.0.0.1": {Mode: "ha", Status: "standby", SharedAddress: "10.0.0.10"}},
+			host:     "10.0.0.1",
+			warnings: 1,
+		},
+		"standby error": {
+			status: map[string]*api.HAResponse{"10.0.0.1": {Mode: "ha", Status: "standby", SharedAddress: "10.0.0.10"}},
+			data:   LoadMasterProviderModel{OnStandby: types.StringValue("error")},
+			host:   "10.0.0.1",
+			errors: 1,
+		},
+		"follow": {
+			status: map[string]*api.HAResponse{
+				"10.0.0.1":  {Mode: "ha", Status: "standby", SharedAddress: "10.0.0.10"},
+				"10.0.0.10": {Mode: "ha", Status: "active", SharedAddress: "10.0.0.10"},
+			},
+			data:     LoadMasterProviderModel{FollowSharedAddress: types.BoolValue(true)},
+			host:     "10.0.0.10",
+			warnings: 1,
+		},
+		"follow unreachable": {
+			status:   map[string]*api.HAResponse{"10.0.0.1": {Mode: "ha", Status: "standby", SharedAddress: "10.0.0.10"}},
+			data:     LoadMasterProviderModel{FollowSharedAddress: types.BoolValue(true), OnStandby: types.StringValue("error")},
+			host:     "10.0.0.1",
+			errors:   1,
+			warnings: 0,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hosts := map[*api.Client]string{}
+			newClient := func(host string) *api.Client {
+				client := api.NewClientWithApiKey(host, "key")
+				hosts[client] = host
+				return client
+			}
+
+			mockShowHighAvailability(t, func(ctx context.Context, client *api.Client) (*api.HAResponse, error) {
+				if response, ok := test.status[hosts[client]]; ok {
+					return response, nil
+				}
+				return nil, errors.New("unreachable")
+			})
+
+			var diags diag.Diagnostics
+			client := configureHighAvailability(t.Context(), newClient("10.0.0.1"), "10.0.0.1", test.data, newClient, &diags)
+
+			if hosts[client] != test.host {
+				t.Errorf("expected client for %s, got %s", test.host, hosts[client])
+			}
+			if diags.WarningsCount() != test.warnings || diags.ErrorsCount() != test.errors {
+				t.Errorf("expected %d warnings and %d errors, got %v", test.warnings, test.errors, diags)
+			}
+		})
+	}
+}
+
+// mockShowHighAvailability replaces how the high availability status is read
+// until the end of the test.
+func mockShowHighAvailability(t *testing.T, show func(ctx context.Context, client *api.Client) (*api.HAResponse, error)) {
+	original := showHighAvailability
+	showHighAvailability = show
+	t.Cleanup(func() {
+		showHighAvailability = original
+	})
+}
+
+func TestConfigureHighAvailabilityFollowsFailover(t *testing.T) {
+	mockShowHighAvailability(t, func(ctx context.Context, client *api.Client) (*api.HAResponse, error) {
+		return &api.HAResponse{Mode: "ha", Status: "active", SharedAddress: "10.0.0.10"}, nil
+	})
+
+	newClient := func(host string) *api.Client {
+		return api.NewClientWithApiKey(host, "key")
+	}
+
+	var diags diag.Diagnostics
+	client := configureHighAvailability(t.Context(), newClient("10.0.0.1"), "10.0.0.1", LoadMasterProviderModel{FollowSharedAddress: types.BoolValue(true)}, newClient, &diags)
+	t.Cleanup(func() { failoverFollowers.Delete(client) })
+
+	value, ok := failoverFollowers.Load(client)
+	if !ok || value.(*failoverFollower).shared != "10.0.0.10" {
+		t.Errorf("expected the active unit to follow a failover to the shared address")
+	}
+	if diags.HasError() || diags.WarningsCount() != 0 {
+		t.Errorf("expected no diagnostics, got: %v", diags)
+	}
+}
+
+func TestFailoverFollower(t *testing.T) {
+	tests := map[string]struct {
+		status   map[string]*api.HAResponse
+		followed bool
+	}{
+		"unit unreachable": {
+			status:   map[string]*api.HAResponse{"10.0.0.10": {Mode: "ha", Status: "active"}},
+			followed: true,
+		},
+		"unit standby": {
+			status: map[string]*api.HAResponse{
+				"10.0.0.1":  {Mode: "ha", Status: "standby"},
+				"10.0.0.10": {Mode: "ha", Status: "active"},
+			},
+			followed: true,
+		},
+		"unit active": {
+			status: map[string]*api.HAResponse{
+				"10.0.0.1":  {Mode: "ha", Status: "active"},
+				"10.0.0.10": {Mode: "ha", Status: "active"},
+			},
+		},
+		"shared address unreachable": {
+			status: map[string]*api.HAResponse{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hosts := map[*api.Client]string{}
+			newClient := func(host string) *api.Client {
+				client := api.NewClientWithApiKey(host, "key")
+				hosts[client] = host
+				return client
+			}
+
+			client := newClient("10.0.0.1")
+			t.Cleanup(func() { clientHosts.Delete(client) })
+
+			follower := &failoverFollower{
+				client:    client,
+				shared:    "10.0.0.10",
+				newClient: newClient,
+				show: func(client *api.Client) (*api.HAResponse, error) {
+					if response, ok := test.status[hosts[client]]; ok {
+						return response, nil
+					}
+					return nil, errors.New("unreachable")
+				},
+			}
+
+			if follower.follow() != test.followed {
+				t.Errorf("expected follow to return %t", test.followed)
+			}
+			if test.followed && clientHost(client) != "10.0.0.10" {
+				t.Errorf("expected the client to connect to the shared address, got %q", clientHost(client))
+			}
+			if follower.follow() {
+				t.Errorf("expected a second follow to leave the client as is")
+			}
+		})
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &RadiusSettingsResource{}
+var _ resource.ResourceWithImportState = &RadiusSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &RadiusSettingsResource{}
+var _ resource.ResourceWithIdentity = &RadiusSettingsResource{}
+
+func NewRadiusSettingsResource() resource.Resource {
+	return &RadiusSettingsResource{}
+}
+
+type RadiusSettingsResource struct {
+	client *api.Client
+}
+
+type RadiusSettingsResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Server               types.String `tfsdk:"server"`
+	Port                 types.Int32  `tfsdk:"port"`
+	Secret               types.String `tfsdk:"secret"`
+	BackupServer         types.String `tfsdk:"backup_server"`
+	BackupPort           types.Int32  `tfsdk:"backup_port"`
+	BackupSecret         types.String `tfsdk:"backup_secret"`
+	SecretVersion        types.Int32  `tfsdk:"secret_version"`
+	RevalidationInterval types.Int32  `tfsdk:"revalidation_interval"`
+}
+
+func (r *RadiusSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_radius_settings"
+}
+
+func (r *RadiusSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the radius settings, always `radius_settings`.")
+}
+
+func (r *RadiusSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the RADIUS servers used for administrator login on the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource only removes it from the state, the servers are kept so administrators are not locked out.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `radius_settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "The address of the RADIUS server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the RADIUS server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "The shared secret of the RADIUS server. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"backup_server": schema.StringAttribute{
+				MarkdownDescription: "The address of the backup RADIUS server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"backup_port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the backup RADIUS server.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"backup_secret": schema.StringAttribute{
+				MarkdownDescription: "The shared secret of the backup RADIUS server. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"secret_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send `secret` and `backup_secret` to the LoadMaster again.",
+				Optional:            true,
+			},
+			"revalidation_interval": schema.Int32Attribute{
+				MarkdownDescription: "The interval in seconds after which a logged in administrator is validated again.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *RadiusSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RadiusSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RadiusSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, server := range map[string]types.String{"server": data.Server, "backup_server": data.BackupServer} {
+		if !server.IsNull() && !server.IsUnknown() && !validServerAddress(server.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid RADIUS Server",
+				fmt.Sprintf("The server must be an address or hostname, got: %s", server.ValueString()),
+			)
+		}
+	}
+
+	for name, port := range map[string]types.Int32{"port": data.Port, "backup_port": data.BackupPort} {
+		if !port.IsNull() && !port.IsUnknown() && (port.ValueInt32() < 1 || port.ValueInt32() > 65535) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid RADIUS Port",
+				fmt.Sprintf("The port must be between 1 and 65535, got: %d", port.ValueInt32()),
+			)
+		}
+	}
+
+	if !data.BackupServer.IsNull() && data.Server.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backup_server"),
+			"Missing RADIUS Server",
+			"A backup server can only be configured together with `server`.",
+		)
+	}
+}
+
+func (r *RadiusSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RadiusSettingsResourceModel
+	var config RadiusSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters := r.parameters(data)
+	parameters.Secret = config.Secret.ValueStringPointer()
+	parameters.BackupSecret = config.BackupSecret.ValueStringPointer()
+
+	operation := ClientBackoff(func() (*api.RadiusResponse, error) {
+		return r.client.ModifyRadius(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create radius settings, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource radius settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *RadiusSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RadiusSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.RadiusResponse, error) {
+		return r.client.ShowRadius()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read radius settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *RadiusSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RadiusSettingsResourceModel
+	var config RadiusSettingsResourceModel
+	var state RadiusSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parameters(data)
+
+	// The secrets are only sent again when their version changes.
+	if !data.SecretVersion.Equal(state.SecretVersion) {
+		parameters.Secret = config.Secret.ValueStringPointer()
+		parameters.BackupSecret = config.BackupSecret.ValueStringPointer()
+	}
+
+	operation := ClientBackoff(func() (*api.RadiusResponse, error) {
+		return r.client.ModifyRadius(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update radius settings, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RadiusSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed radius settings from state, the servers on the LoadMaster are kept")
+}
+
+func (r *RadiusSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RadiusSettingsResourceModel
+
+	operation := ClientBackoff(func() (*api.RadiusResponse, error) {
+		return r.client.ShowRadius()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read radius settings for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *RadiusSettingsResource) parameters(data RadiusSettingsResourceModel) api.RadiusParameters {
+	return api.RadiusParameters{
+		Server:               data.Server.ValueString(),
+		Port:                 data.Port.ValueInt32Pointer(),
+		BackupServer:         data.BackupServer.ValueString(),
+		BackupPort:           data.BackupPort.ValueInt32Pointer(),
+		RevalidationInterval: data.RevalidationInterval.ValueInt32Pointer(),
+	}
+}
+
+func (r *RadiusSettingsResource) fromResponse(data *RadiusSettingsResourceModel, response *api.RadiusResponse) {
+	data.Id = types.StringValue("radius_settings")
+	data.Server = types.StringValue(response.Server)
+	data.Port = types.Int32Value(response.Port)
+	data.Secret = types.StringNull()
+	data.BackupServer = types.StringValue(response.BackupServer)
+	data.BackupPort = types.Int32Value(response.BackupPort)
+	data.BackupSecret = types.StringNull()
+	data.RevalidationInterval = types.Int32Value(response.RevalidationInterval)
+}
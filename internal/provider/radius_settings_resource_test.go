@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestRadiusSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testRadiusSettingsResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_radius_settings.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("radius_settings"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_radius_settings.test",
+						tfjsonpath.New("server"),
+						knownvalue.StringExact("192.0.2.40"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_radius_settings.test",
+						tfjsonpath.New("secret"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_radius_settings.test",
+				ImportState:             true,
+				ImportStateId:           "radius_settings",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_version"},
+			},
+			{
+				Config: testRadiusSettingsResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_radius_settings.test",
+						tfjsonpath.New("port"),
+						knownvalue.Int32Exact(1645),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testRadiusSettingsResourceConfig = `
+resource "loadmaster_radius_settings" "test" {
+  server = "192.0.2.40"
+  port = 1812
+  secret = "radiussecret"
+  secret_version = 1
+}
+`
+
+const testRadiusSettingsResourceConfigUpdate = `
+resource "loadmaster_radius_settings" "test" {
+  server = "192.0.2.40"
+  port = 1645
+  secret = "radiussecret"
+  secret_version = 1
+}
+`
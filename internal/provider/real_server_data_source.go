@@ -73,9 +73,10 @@ func (d *RealServerDataSource) Schema(ctx context.Context, req datasource.Schema
 				Computed:            true,
 			},
 			"forward": schema.StringAttribute{
-				MarkdownDescription: "The forward of the real server.",
+				MarkdownDescription: "The forwarding method of the real server, either `nat` or `route`.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          oneOf(forwardModes...),
 			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "The enable of the real server.",
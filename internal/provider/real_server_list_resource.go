@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ list.ListResource = &RealServerListResource{}
+var _ list.ListResourceWithConfigure = &RealServerListResource{}
+
+func NewRealServerListResource() list.ListResource {
+	return &RealServerListResource{}
+}
+
+type RealServerListResource struct {
+	client *api.Client
+}
+
+type RealServerListResourceModel struct {
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	Address          types.String `tfsdk:"address"`
+	Port             types.String `tfsdk:"port"`
+}
+
+func (r *RealServerListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_real_server"
+}
+
+func (r *RealServerListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the real servers of the LoadMaster.",
+
+		Attributes: map[string]schema.Attribute{
+			"virtual_service_id": schema.StringAttribute{
+				MarkdownDescription: "Only list real servers of this virtual service. By default the real servers of all virtual services are listed.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Only list real servers with this address.",
+				Optional:            true,
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Only list real servers with this port.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *RealServerListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RealServerListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data RealServerListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	virtualServices := []string{}
+	if !data.VirtualServiceId.IsNull() {
+		virtualServices = append(virtualServices, data.VirtualServiceId.ValueString())
+	} else {
+		operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+			return r.client.ListVirtualServices()
+		})
+		response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list virtual services, got error: %s", err))
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		for _, vs := range response.VS {
+			virtualServices = append(virtualServices, strconv.Itoa(int(vs.Index)))
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, vs := range virtualServices {
+			operation := ClientBackoff(func() (*api.ListRealServerResponse, error) {
+				return r.client.ListRealServers(vs)
+			})
+			response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+			if err != nil {
+				result := list.ListResult{}
+				result.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list real servers of virtual service %s, got error: %s", vs, err))
+				push(result)
+				return
+			}
+
+			tflog.SetField(ctx, "response", response)
+			tflog.Trace(ctx, "Received valid response from API")
+
+			for _, rs := range response.Rs {
+				port := strconv.Itoa(int(rs.Port))
+				if !listFilterMatches(data.Address, rs.Address) || !listFilterMatches(data.Port, port) {
+					continue
+				}
+
+				if listLimitReached(req, count) {
+					return
+				}
+				count++
+
+				result := req.NewListResult(ctx)
+				result.DisplayName = fmt.Sprintf("%s:%s (%s)", rs.Address, port, vs)
+
+				virtualServiceId := types.StringValue(strconv.Itoa(int(rs.VSIndex)))
+				result.Diagnostics.Append(result.Identity.Set(ctx, RealServerIdentityModel{
+					VirtualServiceId: virtualServiceId,
+					RealServerId:     types.Int64Value(int64(rs.RsIndex)),
+				})...)
+
+				if req.IncludeResource {
+					result.Diagnostics.Append(result.Resource.Set(ctx, RealServerResourceModel{
+						Id:               types.Int32Value(rs.RsIndex),
+						VirtualServiceId: virtualServiceId,
+						Address:          types.StringValue(rs.Address),
+						Port:             types.StringValue(port),
+						Weight:           types.Int32Value(rs.Weight),
+						Forward:          types.StringValue(rs.Forward),
+						Enable:           types.BoolPointerValue(rs.Enable),
+						Limit:            types.Int32Value(rs.Limit),
+						Critical:         types.BoolPointerValue(rs.Critical),
+						Follow:           types.Int32Value(rs.Follow),
+						DnsName:          types.StringValue(rs.DnsName),
+					})...)
+				}
+
+				if !push(result) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -22,6 +23,7 @@ import (
 
 var _ resource.Resource = &RealServerResource{}
 var _ resource.ResourceWithImportState = &RealServerResource{}
+var _ resource.ResourceWithIdentity = &RealServerResource{}
 
 func NewRealServerResource() resource.Resource {
 	return &RealServerResource{}
@@ -49,6 +51,21 @@ func (r *RealServerResource) Metadata(ctx context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_real_server"
 }
 
+func (r *RealServerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"virtual_service_id": identityschema.StringAttribute{
+				Description:       "Identifier of the virtual service.",
+				RequiredForImport: true,
+			},
+			"real_server_id": identityschema.Int64Attribute{
+				Description:       "Identifier of the real server.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *RealServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages real server.",
@@ -71,6 +88,7 @@ func (r *RealServerResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"address": schema.StringAttribute{
 				MarkdownDescription: "The address of the real server. Should be an IP address.",
 				Required:            true,
+				Validators:          ipAddress(),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -78,6 +96,7 @@ func (r *RealServerResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"port": schema.StringAttribute{
 				MarkdownDescription: "The port of the real server.",
 				Required:            true,
+				Validators:          port(false),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -88,9 +107,10 @@ func (r *RealServerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 			"forward": schema.StringAttribute{
-				MarkdownDescription: "The forward of the real server.",
+				MarkdownDescription: "The forwarding method of the real server, either `nat` or `route`.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          oneOf(forwardModes...),
 			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "The enable of the real server.",
@@ -186,6 +206,7 @@ func (r *RealServerResource) Create(ctx context.Context, req resource.CreateRequ
 	tflog.Trace(ctx, "created a resource real server")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RealServerIdentityModel{VirtualServiceId: data.VirtualServiceId, RealServerId: types.Int64Value(int64(data.Id.ValueInt32()))})...)
 }
 
 func (r *RealServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -227,6 +248,7 @@ func (r *RealServerResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.DnsName = types.StringValue(real_server_response.DnsName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RealServerIdentityModel{VirtualServiceId: data.VirtualServiceId, RealServerId: types.Int64Value(int64(data.Id.ValueInt32()))})...)
 }
 
 func (r *RealServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -294,10 +316,31 @@ func (r *RealServerResource) Delete(ctx context.Context, req resource.DeleteRequ
 func (r *RealServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data RealServerResourceModel
 
-	id_list := strings.Split(req.ID, "/")
+	id := req.ID
+	if identity, ok := importIdentity[RealServerIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		id = fmt.Sprintf("%s/%d", identity.VirtualServiceId.ValueString(), identity.RealServerId.ValueInt64())
+	}
+
+	id_list := strings.Split(id, "/")
+
+	if isNaturalKey(id) {
+		key, err := parseRealServerKey(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", err))
+			return
+		}
+
+		vs, rs, diags := resolveRealServerKey(ctx, r.client, key)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id_list = []string{vs, rs}
+	}
 
 	if len(id_list) != 2 {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", req.ID))
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", id))
 		return
 	}
 
@@ -329,4 +372,5 @@ func (r *RealServerResource) ImportState(ctx context.Context, req resource.Impor
 	data.DnsName = types.StringValue(real_server_response.DnsName)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, RealServerIdentityModel{VirtualServiceId: data.VirtualServiceId, RealServerId: types.Int64Value(int64(data.Id.ValueInt32()))})...)
 }
@@ -12,12 +12,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestRealServerResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -33,6 +37,13 @@ func TestRealServerResource(t *testing.T) {
 						tfjsonpath.New("address"),
 						knownvalue.StringExact("10.0.0.99"),
 					),
+					statecheck.ExpectIdentity(
+						"loadmaster_real_server.test",
+						map[string]knownvalue.Check{
+							"virtual_service_id": knownvalue.NotNull(),
+							"real_server_id":     knownvalue.NotNull(),
+						},
+					),
 				},
 			},
 			{
@@ -41,6 +52,18 @@ func TestRealServerResource(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateIdFunc: generateRealServerImportId,
 			},
+			{
+				ResourceName:      "loadmaster_real_server.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "10.0.0.4:9090:tcp/10.0.0.99:80",
+			},
+			{
+				Config:          testRealServerResourceConfig(),
+				ResourceName:    "loadmaster_real_server.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			{
 				Config: testRealServerResourceConfig(),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var (
+	_ datasource.DataSource              = &RealServersDataSource{}
+	_ datasource.DataSourceWithConfigure = &RealServersDataSource{}
+)
+
+func NewRealServersDataSource() datasource.DataSource {
+	return &RealServersDataSource{}
+}
+
+type RealServersDataSource struct {
+	client *api.Client
+}
+
+type RealServersDataSourceModel struct {
+	VirtualServiceId types.String            `tfsdk:"virtual_service_id"`
+	Address          types.String            `tfsdk:"address"`
+	Port             types.Int32             `tfsdk:"port"`
+	Enable           types.Bool              `tfsdk:"enable"`
+	RealServers      []RealServersEntryModel `tfsdk:"real_servers"`
+}
+
+type RealServersEntryModel struct {
+	Id               types.Int32  `tfsdk:"id"`
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	Address          types.String `tfsdk:"address"`
+	Port             types.Int32  `tfsdk:"port"`
+	Weight           types.Int32  `tfsdk:"weight"`
+	Forward          types.String `tfsdk:"forward"`
+	Enable           types.Bool   `tfsdk:"enable"`
+	Limit            types.Int32  `tfsdk:"limit"`
+	Critical         types.Bool   `tfsdk:"critical"`
+	Follow           types.Int32  `tfsdk:"follow"`
+	DnsName          types.String `tfsdk:"dns_name"`
+}
+
+func (d *RealServersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_real_servers"
+}
+
+func (d *RealServersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the real servers of the LoadMaster. All filters are optional and combined, the real servers are sorted by the id of their virtual service and their own id.",
+
+		Attributes: map[string]schema.Attribute{
+			"virtual_service_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the real servers of this virtual service. By default the real servers of all virtual services are listed.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Only list real servers with this address.",
+				Optional:            true,
+				Validators:          ipAddress(),
+			},
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "Only list real servers with this port.",
+				Optional:            true,
+				Validators:          portNumber(),
+			},
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Only list enabled or disabled real servers.",
+				Optional:            true,
+			},
+			"real_servers": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching real servers.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							MarkdownDescription: "The real server id. This is also called `RIndex` in the LoadMaster API.",
+							Computed:            true,
+						},
+						"virtual_service_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the virtual service. This is also called `Index` in the LoadMaster API.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The address of the real server.",
+							Computed:            true,
+						},
+						"port": schema.Int32Attribute{
+							MarkdownDescription: "The port of the real server.",
+							Computed:            true,
+						},
+						"weight": schema.Int32Attribute{
+							MarkdownDescription: "The weight of the real server.",
+							Computed:            true,
+						},
+						"forward": schema.StringAttribute{
+							MarkdownDescription: "The forwarding method of the real server, either `nat` or `route`.",
+							Computed:            true,
+						},
+						"enable": schema.BoolAttribute{
+							MarkdownDescription: "The enable of the real server.",
+							Computed:            true,
+						},
+						"limit": schema.Int32Attribute{
+							MarkdownDescription: "The limit of the real server.",
+							Computed:            true,
+						},
+						"critical": schema.BoolAttribute{
+							MarkdownDescription: "The critical of the real server.",
+							Computed:            true,
+						},
+						"follow": schema.Int32Attribute{
+							MarkdownDescription: "The follow of the real server.",
+							Computed:            true,
+						},
+						"dns_name": schema.StringAttribute{
+							MarkdownDescription: "The dns name of the real server.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RealServersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RealServersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RealServersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualServices := []string{}
+	if !data.VirtualServiceId.IsNull() {
+		virtualServices = append(virtualServices, data.VirtualServiceId.ValueString())
+	} else {
+		operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+			return d.client.ListVirtualServices()
+		})
+		response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list virtual services, got error: %s", err))
+			return
+		}
+
+		for _, vs := range response.VS {
+			virtualServices = append(virtualServices, strconv.Itoa(int(vs.Index)))
+		}
+	}
+
+	realServers := []api.RealServer{}
+	for _, vs := range virtualServices {
+		operation := ClientBackoff(func() (*api.ListRealServerResponse, error) {
+			return d.client.ListRealServers(vs)
+		})
+		response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list real servers of virtual service %s, got error: %s", vs, err))
+			return
+		}
+
+		tflog.SetField(ctx, "response", response)
+		tflog.Trace(ctx, "Received valid response from API")
+
+		realServers = append(realServers, response.Rs...)
+	}
+
+	data.RealServers = filterRealServers(realServers, data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterRealServers returns the real servers matching the filters of the data
+// source, sorted by the index of their virtual service and their own index.
+func filterRealServers(realServers []api.RealServer, data RealServersDataSourceModel) []RealServersEntryModel {
+	realServers = append([]api.RealServer{}, realServers...)
+	sort.Slice(realServers, func(i, j int) bool {
+		if realServers[i].VSIndex != realServers[j].VSIndex {
+			return realServers[i].VSIndex < realServers[j].VSIndex
+		}
+		return realServers[i].RsIndex < realServers[j].RsIndex
+	})
+
+	entries := []RealServersEntryModel{}
+	for _, rs := range realServers {
+		enable := rs.Enable != nil && *rs.Enable
+
+		if !listFilterMatches(data.Address, rs.Address) ||
+			(!data.Port.IsNull() && data.Port.ValueInt32() != rs.Port) ||
+			(!data.Enable.IsNull() && data.Enable.ValueBool() != enable) {
+			continue
+		}
+
+		entries = append(entries, RealServersEntryModel{
+			Id:               types.Int32Value(rs.RsIndex),
+			VirtualServiceId: types.StringValue(strconv.Itoa(int(rs.VSIndex))),
+			Address:          types.StringValue(rs.Address),
+			Port:             types.Int32Value(rs.Port),
+			Weight:           types.Int32Value(rs.Weight),
+			Forward:          types.StringValue(rs.Forward),
+			Enable:           types.BoolValue(enable),
+			Limit:            types.Int32Value(rs.Limit),
+			Critical:         types.BoolPointerValue(rs.Critical),
+			Follow:           types.Int32Value(rs.Follow),
+			DnsName:          types.StringValue(rs.DnsName),
+		})
+	}
+
+	return entries
+}
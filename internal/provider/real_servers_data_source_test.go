@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestRealServersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testRealServersDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_real_servers.test",
+						tfjsonpath.New("real_servers"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("10.0.0.98"),
+								"port":    knownvalue.Int32Exact(80),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("10.0.0.99"),
+								"port":    knownvalue.Int32Exact(80),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testRealServersDataSourceConfig = `
+resource "loadmaster_virtual_service" "example" {
+	address = "10.0.0.4"
+	port = "9090"
+	protocol = "tcp"
+}
+
+resource "loadmaster_real_server" "a" {
+	virtual_service_id = loadmaster_virtual_service.example.id
+	address = "10.0.0.98"
+	port = "80"
+}
+
+resource "loadmaster_real_server" "b" {
+	virtual_service_id = loadmaster_virtual_service.example.id
+	address = "10.0.0.99"
+	port = "80"
+
+	depends_on = [loadmaster_real_server.a]
+}
+
+data "loadmaster_real_servers" "test" {
+	virtual_service_id = loadmaster_virtual_service.example.id
+
+	depends_on = [loadmaster_real_server.a, loadmaster_real_server.b]
+}
+`
+
+func TestFilterRealServers(t *testing.T) {
+	enabled, disabled := true, false
+	realServers := []api.RealServer{
+		{VSIndex: 2, RsIndex: 1, Address: "10.0.0.10", Port: 80, Enable: &enabled},
+		{VSIndex: 1, RsIndex: 2, Address: "10.0.0.11", Port: 8080, Enable: &disabled},
+		{VSIndex: 1, RsIndex: 1, Address: "10.0.0.10", Port: 80, Enable: &enabled},
+	}
+
+	tests := []struct {
+		name string
+		data RealServersDataSourceModel
+		want []string
+	}{
+		{"all sorted", RealServersDataSourceModel{}, []string{"1/1", "1/2", "2/1"}},
+		{"address", RealServersDataSourceModel{Address: types.StringValue("10.0.0.10")}, []string{"1/1", "2/1"}},
+		{"port", RealServersDataSourceModel{Port: types.Int32Value(8080)}, []string{"1/2"}},
+		{"enable", RealServersDataSourceModel{Enable: types.BoolValue(false)}, []string{"1/2"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := filterRealServers(realServers, tc.data)
+
+			got := []string{}
+			for _, entry := range entries {
+				got = append(got, fmt.Sprintf("%s/%d", entry.VirtualServiceId.ValueString(), entry.Id.ValueInt32()))
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
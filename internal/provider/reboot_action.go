@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ action.Action = &RebootAction{}
+var _ action.ActionWithConfigure = &RebootAction{}
+var _ action.ActionWithValidateConfig = &RebootAction{}
+
+func NewRebootAction() action.Action {
+	return &RebootAction{}
+}
+
+type RebootAction struct {
+	client *api.Client
+}
+
+type RebootActionModel struct {
+	Timeout types.Int32 `tfsdk:"timeout"`
+}
+
+func (a *RebootAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reboot"
+}
+
+func (a *RebootAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This action reboots the LoadMaster and waits until it is reachable again.",
+		Attributes: map[string]schema.Attribute{
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "The time in seconds to wait for the LoadMaster to come back. Defaults to `600`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *RebootAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *RebootAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data RebootActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() && data.Timeout.ValueInt32() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("The timeout must be positive, got: %d", data.Timeout.ValueInt32()),
+		)
+	}
+}
+
+func (a *RebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RebootActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 600 * time.Second
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt32()) * time.Second
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Rebooting the LoadMaster"})
+
+	if err := rebootLoadMaster(a.client); err != nil {
+		resp.Diagnostics.AddError(
+			"Error rebooting LoadMaster",
+			fmt.Sprintf("Could not reboot the LoadMaster: %s", err.Error()),
+		)
+		return
+	}
+
+	version, err := WaitForLoadMasterRestart(ctx, a.client, timeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error rebooting LoadMaster", fmt.Sprintf("The reboot was started, but %s", err.Error()))
+		return
+	}
+
+	tflog.Info(ctx, "Rebooted LoadMaster", map[string]any{"version": version})
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("The LoadMaster is reachable again, running firmware %s", version)})
+}
+
+// rebootLoadMaster starts a reboot. The request is not retried, the
+// LoadMaster may close the connection before it answers, which is not an
+// error.
+func rebootLoadMaster(client *api.Client) error {
+	_, err := client.Reboot()
+	if err != nil && !strings.Contains(err.Error(), "EOF") {
+		return err
+	}
+
+	return nil
+}
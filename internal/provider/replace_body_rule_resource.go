@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ReplaceBodyRuleResource{}
 var _ resource.ResourceWithImportState = &ReplaceBodyRuleResource{}
+var _ resource.ResourceWithIdentity = &ReplaceBodyRuleResource{}
 
 func NewReplaceBodyRuleResource() resource.Resource {
 	return &ReplaceBodyRuleResource{}
@@ -41,6 +42,10 @@ func (r *ReplaceBodyRuleResource) Metadata(ctx context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_replace_body_rule"
 }
 
+func (r *ReplaceBodyRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *ReplaceBodyRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `ReplaceBodyRule`.",
@@ -68,14 +73,16 @@ func (r *ReplaceBodyRuleResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 		},
 	}
@@ -139,6 +146,7 @@ func (r *ReplaceBodyRuleResource) Create(ctx context.Context, req resource.Creat
 	tflog.Trace(ctx, "created a resource replace body rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ReplaceBodyRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -175,6 +183,7 @@ func (r *ReplaceBodyRuleResource) Read(ctx context.Context, req resource.ReadReq
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ReplaceBodyRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -237,8 +246,10 @@ func (r *ReplaceBodyRuleResource) Delete(ctx context.Context, req resource.Delet
 func (r *ReplaceBodyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ReplaceBodyRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 	if err != nil {
@@ -261,4 +272,5 @@ func (r *ReplaceBodyRuleResource) ImportState(ctx context.Context, req resource.
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
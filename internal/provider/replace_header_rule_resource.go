@@ -19,6 +19,7 @@ import (
 
 var _ resource.Resource = &ReplaceHeaderRuleResource{}
 var _ resource.ResourceWithImportState = &ReplaceHeaderRuleResource{}
+var _ resource.ResourceWithIdentity = &ReplaceHeaderRuleResource{}
 
 func NewReplaceHeaderRuleResource() resource.Resource {
 	return &ReplaceHeaderRuleResource{}
@@ -41,6 +42,10 @@ func (r *ReplaceHeaderRuleResource) Metadata(ctx context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_replace_header_rule"
 }
 
+func (r *ReplaceHeaderRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("The name of the rule.")
+}
+
 func (r *ReplaceHeaderRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a `ReplaceHeaderRule`.",
@@ -67,14 +72,16 @@ func (r *ReplaceHeaderRuleResource) Schema(ctx context.Context, req resource.Sch
 				Required:            true,
 			},
 			"only_on_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 			"only_on_no_flag": schema.Int32Attribute{
-				MarkdownDescription: "Only try to execute this rule if the specified flag is not set.",
+				MarkdownDescription: "Only try to execute this rule if the specified flag is not set, from 1 to 9. `0` means no flag.",
 				Optional:            true,
 				Computed:            true,
+				Validators:          flagNumber(),
 			},
 		},
 	}
@@ -138,6 +145,7 @@ func (r *ReplaceHeaderRuleResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created a resource replace header rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ReplaceHeaderRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,6 +182,7 @@ func (r *ReplaceHeaderRuleResource) Read(ctx context.Context, req resource.ReadR
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *ReplaceHeaderRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -236,8 +245,10 @@ func (r *ReplaceHeaderRuleResource) Delete(ctx context.Context, req resource.Del
 func (r *ReplaceHeaderRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data ReplaceHeaderRuleResourceModel
 
+	id := importId(ctx, req, &resp.Diagnostics)
+
 	operation := ClientBackoff(func() (*api.RuleResponse, error) {
-		return r.client.ShowRule(req.ID)
+		return r.client.ShowRule(id)
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 
@@ -261,4 +272,5 @@ func (r *ReplaceHeaderRuleResource) ImportState(ctx context.Context, req resourc
 	data.OnlyOnNoFlag = types.Int32PointerValue(rule.OnlyOnNoFlag)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
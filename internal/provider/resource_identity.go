@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdIdentityModel is the identity of resources which are identified by their
+// `id` attribute alone.
+type IdIdentityModel struct {
+	Id types.String `tfsdk:"id"`
+}
+
+func idIdentitySchema(description string) identityschema.Schema {
+	return stringIdentitySchema("id", description)
+}
+
+// stringIdentitySchema returns the identity schema of resources which are
+// identified by a single string attribute.
+func stringIdentitySchema(attribute string, description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			attribute: identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// importId returns the ID of an import of a resource with an IdIdentityModel,
+// given either as string or as identity.
+func importId(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	return importAttribute(ctx, req, "id", diags)
+}
+
+// importAttribute returns the ID of an import of a resource with a
+// stringIdentitySchema, given either as string or as identity.
+func importAttribute(ctx context.Context, req resource.ImportStateRequest, attribute string, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	var value types.String
+	diags.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+	return value.ValueString()
+}
+
+// importIdentity returns the identity of an import of a resource with a
+// composite identity, and false if the import is given as string ID instead.
+func importIdentity[T any](ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) (T, bool) {
+	var identity T
+	if req.ID != "" || req.Identity == nil {
+		return identity, false
+	}
+
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+
+	return identity, true
+}
+
+// RealServerIdentityModel is the identity of a real server, which is only
+// unique within its virtual service.
+type RealServerIdentityModel struct {
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	RealServerId     types.Int64  `tfsdk:"real_server_id"`
+}
+
+// FilenameIdentityModel is the identity of the OWASP custom rules and data,
+// which are identified by their file name.
+type FilenameIdentityModel struct {
+	Filename types.String `tfsdk:"filename"`
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ action.Action = &RestoreAction{}
+var _ action.ActionWithConfigure = &RestoreAction{}
+var _ action.ActionWithValidateConfig = &RestoreAction{}
+
+func NewRestoreAction() action.Action {
+	return &RestoreAction{}
+}
+
+type RestoreAction struct {
+	client *api.Client
+}
+
+type RestoreActionModel struct {
+	File                  types.String `tfsdk:"file"`
+	Scope                 types.String `tfsdk:"scope"`
+	Confirm               types.Bool   `tfsdk:"confirm"`
+	AllowHostnameMismatch types.Bool   `tfsdk:"allow_hostname_mismatch"`
+	AllowVersionMismatch  types.Bool   `tfsdk:"allow_version_mismatch"`
+	Timeout               types.Int32  `tfsdk:"timeout"`
+}
+
+// backupInfo holds the metadata of a backup archive.
+type backupInfo struct {
+	Version  string
+	Hostname string
+}
+
+func (a *RestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore"
+}
+
+func (a *RestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This action restores a configuration backup created by the `loadmaster_backup` action or the LoadMaster itself. " +
+			"Before the archive is uploaded, its firmware version and hostname are compared with the LoadMaster. " +
+			"After the restore the action waits until the LoadMaster is reachable again, for the `base` and `all` scopes it first waits for the LoadMaster to restart.",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				MarkdownDescription: "The path of the local backup archive.",
+				Required:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "What to restore. One of `base` (base configuration), `vs` (virtual services), `geo` (GEO configuration), `certificates` or `all`.",
+				Required:            true,
+			},
+			"confirm": schema.BoolAttribute{
+				MarkdownDescription: "Must be set to `true`, the restore overwrites the configuration of the LoadMaster.",
+				Required:            true,
+			},
+			"allow_hostname_mismatch": schema.BoolAttribute{
+				MarkdownDescription: "Restore the archive even if it was created on a LoadMaster with a different hostname, e.g. when restoring to a standby appliance. Defaults to `false`.",
+				Optional:            true,
+			},
+			"allow_version_mismatch": schema.BoolAttribute{
+				MarkdownDescription: "Restore the archive even if it was created with a newer firmware than the one running on the LoadMaster. Defaults to `false`.",
+				Optional:            true,
+			},
+			"timeout": schema.Int32Attribute{
+				MarkdownDescription: "The time in seconds to wait for the LoadMaster to become reachable after the restore. Defaults to `600`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *RestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *RestoreAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data RestoreActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Confirm.IsUnknown() && !data.Confirm.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm"),
+			"Restore Not Confirmed",
+			"The restore overwrites the configuration of the LoadMaster, set `confirm` to `true` to allow it.",
+		)
+	}
+
+	if !data.Scope.IsUnknown() {
+		switch data.Scope.ValueString() {
+		case "base", "vs", "geo", "certificates", "all":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("scope"),
+				"Invalid Restore Scope",
+				fmt.Sprintf("The scope must be one of `base`, `vs`, `geo`, `certificates` or `all`, got: %s", data.Scope.ValueString()),
+			)
+		}
+	}
+
+	if !data.Timeout.IsNull() && !data.Timeout.IsUnknown() && data.Timeout.ValueInt32() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("timeout"),
+			"Invalid Timeout",
+			fmt.Sprintf("The timeout must be positive, got: %d", data.Timeout.ValueInt32()),
+		)
+	}
+}
+
+func (a *RestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RestoreActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Validating backup %s", data.File.ValueString())})
+
+	archive, err := os.ReadFile(data.File.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("file"), "Error reading backup", err.Error())
+		return
+	}
+
+	info, err := inspectBackup(archive)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Invalid backup",
+			fmt.Sprintf("The file %s is not a valid LoadMaster backup: %s", data.File.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	target := backupInfo{}
+	for param, value := range map[string]*string{"version": &target.Version, "hostname": &target.Hostname} {
+		operation := ClientBackoff(func() (*api.ParameterResponse, error) {
+			return a.client.GetParameter(param)
+		})
+		response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s of the LoadMaster, got error: %s", param, err))
+			return
+		}
+		*value = response.Value
+	}
+
+	if !strings.EqualFold(info.Hostname, target.Hostname) {
+		if !data.AllowHostnameMismatch.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allow_hostname_mismatch"),
+				"Hostname Mismatch",
+				fmt.Sprintf("The backup was created on %s, but the LoadMaster is %s. Set `allow_hostname_mismatch` to restore it anyway.", info.Hostname, target.Hostname),
+			)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Hostname Mismatch",
+			fmt.Sprintf("The backup was created on %s, but the LoadMaster is %s.", info.Hostname, target.Hostname),
+		)
+	}
+
+	if compareFirmwareVersions(info.Version, target.Version) > 0 {
+		if !data.AllowVersionMismatch.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allow_version_mismatch"),
+				"Firmware Version Mismatch",
+				fmt.Sprintf("The backup was created with firmware %s, which is newer than %s running on the LoadMaster. Set `allow_version_mismatch` to restore it anyway.", info.Version, target.Version),
+			)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Firmware Version Mismatch",
+			fmt.Sprintf("The backup was created with firmware %s, which is newer than %s running on the LoadMaster.", info.Version, target.Version),
+		)
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restoring %s configuration from %s (firmware %s)", data.Scope.ValueString(), info.Hostname, info.Version),
+	})
+
+	// The restore is not retried, the LoadMaster may close the connection
+	// while it restarts its services, which is not an error.
+	_, err = a.client.RestoreBackup(archive, data.Scope.ValueString())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		resp.Diagnostics.AddError(
+			"Error restoring backup",
+			fmt.Sprintf("Could not restore backup %s: %s", data.File.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	timeout := 600 * time.Second
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt32()) * time.Second
+	}
+
+	// Restoring the base configuration restarts the LoadMaster, it may still
+	// answer requests right after the upload.
+	wait := WaitForLoadMaster
+	if scope := data.Scope.ValueString(); scope == "base" || scope == "all" {
+		wait = WaitForLoadMasterRestart
+	}
+
+	_, err = wait(ctx, a.client, timeout, func(message string) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error restoring backup", fmt.Sprintf("The backup was uploaded, but %s", err.Error()))
+		return
+	}
+
+	tflog.Info(ctx, "Restored backup", map[string]any{"file": data.File.ValueString(), "scope": data.Scope.ValueString()})
+	resp.SendProgress(action.InvokeProgressEvent{Message: "Restore finished, the LoadMaster is reachable"})
+}
+
+// inspectBackup reads the firmware version and hostname from a backup
+// archive.
+func inspectBackup(archive []byte) (backupInfo, error) {
+	entries, err := readArchiveEntries(archive, "version", "hostname")
+	if err != nil {
+		return backupInfo{}, err
+	}
+
+	info := backupInfo{Version: entries["version"], Hostname: entries["hostname"]}
+	if info.Version == "" || info.Hostname == "" {
+		return info, errors.New("the archive does not contain the firmware version and hostname")
+	}
+
+	return info, nil
+}
+
+// readArchiveEntries reads the first line of the entries with the given base
+// names from a gzip compressed tar archive. Entries which are not found are
+// missing from the result.
+func readArchiveEntries(archive []byte, names ...string) (map[string]string, error) {
+	entries := map[string]string{}
+
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := filepath.Base(header.Name)
+		if !slices.Contains(names, name) {
+			continue
+		}
+
+		line, err := bufio.NewReader(io.LimitReader(reader, 4096)).ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		entries[name] = strings.TrimSpace(line)
+	}
+
+	return entries, nil
+}
+
+// compareFirmwareVersions compares two dotted firmware versions like
+// `7.2.59.0.22007` numerically and returns -1, 0 or 1.
+func compareFirmwareVersions(a string, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRestoreActionNotConfirmed(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+action "loadmaster_restore" "this" {
+  config {
+    file    = "backup.tar.gz"
+    scope   = "all"
+    confirm = false
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Restore Not Confirmed"),
+			},
+		},
+	})
+}
+
+func TestInspectBackup(t *testing.T) {
+	archive := testBackupArchive(t, map[string]string{
+		"config/version":  "7.2.59.0.22007\n",
+		"config/hostname": "lb01\n",
+		"config/vs.conf":  "vs",
+	})
+
+	info, err := inspectBackup(archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if info.Version != "7.2.59.0.22007" {
+		t.Errorf("expected version 7.2.59.0.22007, got %s", info.Version)
+	}
+	if info.Hostname != "lb01" {
+		t.Errorf("expected hostname lb01, got %s", info.Hostname)
+	}
+
+	if _, err := inspectBackup(testBackupArchive(t, map[string]string{"config/vs.conf": "vs"})); err == nil {
+		t.Error("expected an error for an archive without metadata")
+	}
+
+	if _, err := inspectBackup([]byte("not an archive")); err == nil {
+		t.Error("expected an error for an invalid archive")
+	}
+}
+
+func TestCompareFirmwareVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"7.2.59.0.22007", "7.2.59.0.22007", 0},
+		{"7.2.59", "7.2.60", -1},
+		{"7.2.100", "7.2.99", 1},
+		{"7.2.59.1", "7.2.59", 1},
+		{"7.2", "7.2.0.0", 0},
+	}
+
+	for _, test := range tests {
+		if actual := compareFirmwareVersions(test.a, test.b); actual != test.expected {
+			t.Errorf("compareFirmwareVersions(%q, %q): expected %d, got %d", test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+func testBackupArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buffer := bytes.Buffer{}
+	gz := gzip.NewWriter(&buffer)
+	writer := tar.NewWriter(gz)
+
+	for name, content := range files {
+		if err := writer.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := writer.Write([]byte(content)); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return buffer.Bytes()
+}
@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &RouteResource{}
+var _ resource.ResourceWithImportState = &RouteResource{}
+var _ resource.ResourceWithValidateConfig = &RouteResource{}
+var _ resource.ResourceWithIdentity = &RouteResource{}
+
+func NewRouteResource() resource.Resource {
+	return &RouteResource{}
+}
+
+type RouteResource struct {
+	client *api.Client
+}
+
+type RouteResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Destination  types.String `tfsdk:"destination"`
+	Gateway      types.String `tfsdk:"gateway"`
+	InterfaceId  types.Int32  `tfsdk:"interface_id"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+}
+
+func (r *RouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route"
+}
+
+func (r *RouteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("destination", "The destination of the route.")
+}
+
+func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a static route of the LoadMaster.\n\nA route the provider is connected to the LoadMaster through is not destroyed unless `force_destroy` is set. " +
+			"Routes added outside of Terraform are not detected, use `loadmaster_route_table` to manage all routes instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the route, same as `destination`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "The destination network of the route in CIDR notation, e.g. `192.168.0.0/16`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				MarkdownDescription: "The address of the gateway the destination is reached through.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface_id": schema.Int32Attribute{
+				MarkdownDescription: "The id of the interface the route is bound to. Defaults to the interface the gateway is reachable on.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Destroy the route even when the provider is connected to the LoadMaster through it.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *RouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RouteResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RouteResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Destination.IsNull() && !data.Destination.IsUnknown() {
+		if _, _, err := net.ParseCIDR(data.Destination.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("destination"),
+				"Invalid Route Destination",
+				fmt.Sprintf("The destination must be in CIDR notation, got: %s", data.Destination.ValueString()),
+			)
+		}
+	}
+
+	if !data.Gateway.IsNull() && !data.Gateway.IsUnknown() {
+		if net.ParseIP(data.Gateway.ValueString()) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("gateway"),
+				"Invalid Route Gateway",
+				fmt.Sprintf("The gateway must be an IP address, got: %s", data.Gateway.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RouteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "destination", data.Destination)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+		return r.client.AddRoute(data.Destination.ValueString(), data.Gateway.ValueString(), api.RouteParameters{
+			Interface: data.InterfaceId.ValueInt32Pointer(),
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create route, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	route := findRoute(response.Routes, data.Destination.ValueString())
+	if route == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find route %s after creation", data.Destination.ValueString()))
+		return
+	}
+
+	r.fromResponse(&data, route)
+
+	tflog.Trace(ctx, "created a resource route")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("destination"), data.Destination)...)
+}
+
+func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RouteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+		return r.client.ListRoutes()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read route, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	// A changed gateway or interface is reported as drift, a removed route is recreated.
+	route := findRoute(response.Routes, data.Destination.ValueString())
+	if route == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	r.fromResponse(&data, route)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("destination"), data.Destination)...)
+}
+
+func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RouteResourceModel
+
+	// Only force_destroy can change in place, it is not sent to the LoadMaster.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RouteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ForceDestroy.ValueBool() {
+		destination, err := connectionRoute(ctx, r.client, []string{data.Destination.ValueString()})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list interfaces, got error: %s", err))
+			return
+		}
+
+		if destination != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("destination"),
+				"Refusing To Delete Route",
+				fmt.Sprintf("The provider is connected to the LoadMaster through the route %s, deleting it would disconnect the provider. "+
+					"Set force_destroy to true to delete the route anyway.", destination),
+			)
+			return
+		}
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteRoute(data.Destination.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete route, got error: %s", err))
+		return
+	}
+}
+
+func (r *RouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RouteResourceModel
+
+	destination := importAttribute(ctx, req, "destination", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+		return r.client.ListRoutes()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read route for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	route := findRoute(response.Routes, destination)
+	if route == nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("Unable to find route with destination %s", destination))
+		return
+	}
+
+	r.fromResponse(&data, route)
+	data.ForceDestroy = types.BoolNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("destination"), data.Destination)...)
+}
+
+func (r *RouteResource) fromResponse(data *RouteResourceModel, route *api.RouteResponse) {
+	data.Id = types.StringValue(route.Destination)
+	data.Destination = types.StringValue(route.Destination)
+	data.Gateway = types.StringValue(route.Gateway)
+	data.InterfaceId = types.Int32Value(route.Interface)
+}
+
+func findRoute(routes []api.RouteResponse, destination string) *api.RouteResponse {
+	for i := range routes {
+		if routes[i].Destination == destination {
+			return &routes[i]
+		}
+	}
+
+	return nil
+}
+
+// connectionRoute returns the first of the destinations whose route the
+// provider is connected to the LoadMaster through, or an empty string.
+func connectionRoute(ctx context.Context, client *api.Client, destinations []string) (string, error) {
+	if len(destinations) == 0 {
+		return "", nil
+	}
+
+	local := localAddress(clientHost(client))
+	if local == nil {
+		tflog.Warn(ctx, "Unable to determine the local address of the connection, skipping the route check")
+		return "", nil
+	}
+
+	operation := ClientBackoff(func() (*api.ListInterfaceResponse, error) {
+		return client.ListInterfaces()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return "", err
+	}
+
+	for _, destination := range destinations {
+		if routeCarriesConnection(destination, local, response.Interfaces) {
+			return destination, nil
+		}
+	}
+
+	return "", nil
+}
+
+// localAddress returns the local address this machine uses to reach the
+// configured host of the provider, or nil if it cannot be resolved. Dialing
+// UDP does not send any packets, it only asks the operating system for the
+// route.
+func localAddress(host string) net.IP {
+	if host == "" {
+		return nil
+	}
+
+	conn, err := net.Dial("udp", net.JoinHostPort(hostAddress(host), "443"))
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	return conn.LocalAddr().(*net.UDPAddr).IP
+}
+
+// routeCarriesConnection reports whether the LoadMaster answers the local
+// address through the route to destination. An address on a network directly
+// attached to the LoadMaster does not depend on any route.
+func routeCarriesConnection(destination string, local net.IP, interfaces []api.InterfaceResponse) bool {
+	_, network, err := net.ParseCIDR(destination)
+	if err != nil {
+		return false
+	}
+
+	return network.Contains(local) && !interfaceContainsAddress(interfaces, local.String())
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestRouteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testRouteResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_route.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("198.18.0.0/15"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_route.test",
+						tfjsonpath.New("gateway"),
+						knownvalue.StringExact("10.0.0.254"),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_route.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				Config:          testRouteResourceConfig,
+				ResourceName:    "loadmaster_route.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestRouteCarriesConnection(t *testing.T) {
+	interfaces := []api.InterfaceResponse{
+		{Id: 0, Address: "10.0.0.10/24"},
+	}
+
+	tests := []struct {
+		destination string
+		local       string
+		expected    bool
+	}{
+		{"192.168.0.0/16", "192.168.1.20", true},
+		{"192.168.0.0/16", "172.16.0.20", false},
+		{"10.0.0.0/8", "10.0.0.20", false},
+		{"invalid", "192.168.1.20", false},
+	}
+
+	for _, test := range tests {
+		actual := routeCarriesConnection(test.destination, net.ParseIP(test.local), interfaces)
+
+		if actual != test.expected {
+			t.Errorf("routeCarriesConnection(%q, %q) = %t, expected %t", test.destination, test.local, actual, test.expected)
+		}
+	}
+}
+
+const testRouteResourceConfig = `
+resource "loadmaster_route" "test" {
+  destination = "198.18.0.0/15"
+  gateway = "10.0.0.254"
+}
+`
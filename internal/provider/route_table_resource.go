@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &RouteTableResource{}
+var _ resource.ResourceWithImportState = &RouteTableResource{}
+var _ resource.ResourceWithValidateConfig = &RouteTableResource{}
+var _ resource.ResourceWithIdentity = &RouteTableResource{}
+
+func NewRouteTableResource() resource.Resource {
+	return &RouteTableResource{}
+}
+
+type RouteTableResource struct {
+	client *api.Client
+}
+
+type RouteTableResourceModel struct {
+	Id           types.String           `tfsdk:"id"`
+	Routes       []RouteTableEntryModel `tfsdk:"routes"`
+	ForceDestroy types.Bool             `tfsdk:"force_destroy"`
+}
+
+type RouteTableEntryModel struct {
+	Destination types.String `tfsdk:"destination"`
+	Gateway     types.String `tfsdk:"gateway"`
+	InterfaceId types.Int32  `tfsdk:"interface_id"`
+}
+
+func (r *RouteTableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_route_table"
+}
+
+func (r *RouteTableResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the route table, always `route_table`.")
+}
+
+func (r *RouteTableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages all static routes of the LoadMaster.\n\nThis resource is authoritative, routes which are not configured are removed, and routes added outside of Terraform show up as changes. " +
+			"It must not be combined with `loadmaster_route` resources. A route the provider is connected to the LoadMaster through is not removed unless `force_destroy` is set.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the route table, always `route_table`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routes": schema.SetNestedAttribute{
+				MarkdownDescription: "The static routes of the LoadMaster.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": schema.StringAttribute{
+							MarkdownDescription: "The destination network of the route in CIDR notation, e.g. `192.168.0.0/16`.",
+							Required:            true,
+						},
+						"gateway": schema.StringAttribute{
+							MarkdownDescription: "The address of the gateway the destination is reached through.",
+							Required:            true,
+							Validators:          ipAddress(),
+						},
+						"interface_id": schema.Int32Attribute{
+							MarkdownDescription: "The id of the interface the route is bound to. Defaults to the interface the gateway is reachable on.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Remove routes even when the provider is connected to the LoadMaster through them.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *RouteTableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RouteTableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RouteTableResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destinations := map[string]bool{}
+	for _, route := range data.Routes {
+		if route.Destination.IsNull() || route.Destination.IsUnknown() {
+			continue
+		}
+
+		destination := route.Destination.ValueString()
+		if _, _, err := net.ParseCIDR(destination); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routes"),
+				"Invalid Route Destination",
+				fmt.Sprintf("The destination must be in CIDR notation, got: %s", destination),
+			)
+		}
+
+		if destinations[destination] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("routes"),
+				"Duplicate Route Destination",
+				fmt.Sprintf("The destination %s is configured more than once.", destination),
+			)
+		}
+		destinations[destination] = true
+	}
+}
+
+func (r *RouteTableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RouteTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	// The resource is authoritative, so the existing routes are diffed
+	// against the configuration instead of assuming an empty table.
+	current, err := r.read(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	if !r.apply(ctx, current, data.Routes, data.ForceDestroy.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	routes, err := r.read(ctx, data.Routes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("route_table")
+	data.Routes = routes
+
+	tflog.Trace(ctx, "created a resource route table")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *RouteTableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RouteTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes, err := r.read(ctx, data.Routes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("route_table")
+	data.Routes = routes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *RouteTableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RouteTableResourceModel
+	var state RouteTableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.apply(ctx, state.Routes, data.Routes, data.ForceDestroy.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	routes, err := r.read(ctx, data.Routes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("route_table")
+	data.Routes = routes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RouteTableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RouteTableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, data.Routes, nil, data.ForceDestroy.ValueBool(), &resp.Diagnostics)
+}
+
+func (r *RouteTableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RouteTableResourceModel
+
+	routes, err := r.read(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read routes for import, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue("route_table")
+	data.Routes = routes
+	data.ForceDestroy = types.BoolNull()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+// read returns the routes of the LoadMaster. The interface of a route is left
+// null if it is not configured in known, so the default interface chosen by
+// the LoadMaster does not show up as a change.
+func (r *RouteTableResource) read(ctx context.Context, known []RouteTableEntryModel) ([]RouteTableEntryModel, error) {
+	operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+		return r.client.ListRoutes()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	defaultInterface := map[string]bool{}
+	for _, route := range known {
+		if route.InterfaceId.IsNull() {
+			defaultInterface[route.Destination.ValueString()] = true
+		}
+	}
+
+	routes := []RouteTableEntryModel{}
+	for _, route := range response.Routes {
+		entry := RouteTableEntryModel{
+			Destination: types.StringValue(route.Destination),
+			Gateway:     types.StringValue(route.Gateway),
+			InterfaceId: types.Int32Value(route.Interface),
+		}
+		if defaultInterface[route.Destination] {
+			entry.InterfaceId = types.Int32Null()
+		}
+
+		routes = append(routes, entry)
+	}
+
+	return routes, nil
+}
+
+// apply turns the current routes into the desired ones. Routes the provider
+// is connected through are only removed with forceDestroy, otherwise an
+// error is added and nothing is changed.
+func (r *RouteTableResource) apply(ctx context.Context, current []RouteTableEntryModel, desired []RouteTableEntryModel, forceDestroy bool, diags *diag.Diagnostics) bool {
+	removed, added := diffRouteTableEntries(current, desired)
+
+	if !forceDestroy {
+		destinations := make([]string, len(removed))
+		for i, route := range removed {
+			destinations[i] = route.Destination.ValueString()
+		}
+
+		destination, err := connectionRoute(ctx, r.client, destinations)
+		if err != nil {
+			diags.AddAttributeError(path.Root("routes"), "Client Error", fmt.Sprintf("Unable to list interfaces, got error: %s", err))
+			return false
+		}
+
+		if destination != "" {
+			diags.AddAttributeError(
+				path.Root("routes"),
+				"Refusing To Delete Route",
+				fmt.Sprintf("The provider is connected to the LoadMaster through the route %s, removing it would disconnect the provider. "+
+					"Set force_destroy to true to remove the route anyway.", destination),
+			)
+			return false
+		}
+	}
+
+	for _, route := range removed {
+		tflog.Debug(ctx, "removing route", map[string]any{"destination": route.Destination.ValueString()})
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			return r.client.DeleteRoute(route.Destination.ValueString())
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("routes"), "Client Error", fmt.Sprintf("Unable to remove route %s, got error: %s", route.Destination.ValueString(), err))
+			return false
+		}
+	}
+
+	for _, route := range added {
+		tflog.Debug(ctx, "adding route", map[string]any{"destination": route.Destination.ValueString()})
+
+		operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+			return r.client.AddRoute(route.Destination.ValueString(), route.Gateway.ValueString(), api.RouteParameters{
+				Interface: route.InterfaceId.ValueInt32Pointer(),
+			})
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("routes"), "Client Error", fmt.Sprintf("Unable to add route %s, got error: %s", route.Destination.ValueString(), err))
+			return false
+		}
+	}
+
+	return true
+}
+
+// diffRouteTableEntries returns the routes to remove and to add to turn the
+// current routes into the desired ones. Routes are identified by destination,
+// a route with a changed gateway or interface is removed and added again. An
+// interface which is not set in the desired route is not compared.
+func diffRouteTableEntries(current []RouteTableEntryModel, desired []RouteTableEntryModel) ([]RouteTableEntryModel, []RouteTableEntryModel) {
+	existing := map[string]RouteTableEntryModel{}
+	for _, route := range current {
+		existing[route.Destination.ValueString()] = route
+	}
+
+	wanted := map[string]RouteTableEntryModel{}
+	for _, route := range desired {
+		wanted[route.Destination.ValueString()] = route
+	}
+
+	changed := func(route RouteTableEntryModel, want RouteTableEntryModel) bool {
+		return route.Gateway.ValueString() != want.Gateway.ValueString() ||
+			(!want.InterfaceId.IsNull() && !want.InterfaceId.Equal(route.InterfaceId))
+	}
+
+	var remove []RouteTableEntryModel
+	for _, route := range current {
+		want, ok := wanted[route.Destination.ValueString()]
+		if !ok || changed(route, want) {
+			remove = append(remove, route)
+		}
+	}
+
+	var add []RouteTableEntryModel
+	for _, route := range desired {
+		have, ok := existing[route.Destination.ValueString()]
+		if !ok || changed(have, route) {
+			add = append(add, route)
+		}
+	}
+
+	return remove, add
+}
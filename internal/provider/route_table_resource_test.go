@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestRouteTableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testRouteTableResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_route_table.test",
+						tfjsonpath.New("routes"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"destination":  knownvalue.StringExact("198.18.0.0/15"),
+								"gateway":      knownvalue.StringExact("10.0.0.254"),
+								"interface_id": knownvalue.Int32Exact(0),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_route_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			// A route added outside of Terraform shows up as a change
+			{
+				PreConfig: func() {
+					addRouteTableTestRoute(t, "192.0.2.0/24", "10.0.0.254")
+				},
+				Config:             testRouteTableResourceConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testRouteTableResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_route_table.test",
+						tfjsonpath.New("routes"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestDiffRouteTableEntries(t *testing.T) {
+	route := func(destination string, gateway string, iface types.Int32) RouteTableEntryModel {
+		return RouteTableEntryModel{
+			Destination: types.StringValue(destination),
+			Gateway:     types.StringValue(gateway),
+			InterfaceId: iface,
+		}
+	}
+
+	current := []RouteTableEntryModel{
+		route("192.168.0.0/16", "10.0.0.254", types.Int32Value(0)),
+		route("172.16.0.0/12", "10.0.0.254", types.Int32Value(0)),
+		route("198.18.0.0/15", "10.0.0.254", types.Int32Value(0)),
+	}
+	desired := []RouteTableEntryModel{
+		route("192.168.0.0/16", "10.0.0.254", types.Int32Null()),
+		route("172.16.0.0/12", "10.0.0.253", types.Int32Null()),
+		route("203.0.113.0/24", "10.0.0.254", types.Int32Value(1)),
+	}
+
+	removed, added := diffRouteTableEntries(current, desired)
+
+	if len(removed) != 2 || removed[0].Destination.ValueString() != "172.16.0.0/12" || removed[1].Destination.ValueString() != "198.18.0.0/15" {
+		t.Errorf("unexpected removed routes: %v", removed)
+	}
+
+	if len(added) != 2 || added[0].Destination.ValueString() != "172.16.0.0/12" || added[1].Destination.ValueString() != "203.0.113.0/24" {
+		t.Errorf("unexpected added routes: %v", added)
+	}
+}
+
+func TestDiffRouteTableEntriesInterface(t *testing.T) {
+	route := func(destination string, iface types.Int32) RouteTableEntryModel {
+		return RouteTableEntryModel{
+			Destination: types.StringValue(destination),
+			Gateway:     types.StringValue("10.0.0.254"),
+			InterfaceId: iface,
+		}
+	}
+
+	tests := map[string]struct {
+		current types.Int32
+		desired types.Int32
+		changed bool
+	}{
+		"unset":      {current: types.Int32Value(0), desired: types.Int32Null()},
+		"same":       {current: types.Int32Value(1), desired: types.Int32Value(1)},
+		"changed":    {current: types.Int32Value(0), desired: types.Int32Value(1), changed: true},
+		"set":        {current: types.Int32Null(), desired: types.Int32Value(1), changed: true},
+		"still null": {current: types.Int32Null(), desired: types.Int32Null()},
+	}
+
+	for name, test := range tests {
+		removed, added := diffRouteTableEntries(
+			[]RouteTableEntryModel{route("198.18.0.0/15", test.current)},
+			[]RouteTableEntryModel{route("198.18.0.0/15", test.desired)},
+		)
+
+		if test.changed != (len(removed) == 1 && len(added) == 1) || (!test.changed && (len(removed) != 0 || len(added) != 0)) {
+			t.Errorf("%s: expected changed to be %t, got removed %v and added %v", name, test.changed, removed, added)
+		}
+	}
+}
+
+func addRouteTableTestRoute(t *testing.T, destination string, gateway string) {
+	client := api.NewClientWithApiKey(os.Getenv("LOADMASTER_HOST"), os.Getenv("LOADMASTER_API_KEY"))
+
+	operation := ClientBackoff(func() (*api.ListRouteResponse, error) {
+		return client.AddRoute(destination, gateway, api.RouteParameters{})
+	})
+	_, err := backoff.Retry(t.Context(), operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+const testRouteTableResourceConfig = `
+resource "loadmaster_route_table" "test" {
+  routes = [
+    {
+      destination  = "198.18.0.0/15"
+      gateway      = "10.0.0.254"
+      interface_id = 0
+    },
+  ]
+}
+`
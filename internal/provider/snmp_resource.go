@@ -0,0 +1,526 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &SnmpResource{}
+var _ resource.ResourceWithImportState = &SnmpResource{}
+var _ resource.ResourceWithValidateConfig = &SnmpResource{}
+var _ resource.ResourceWithIdentity = &SnmpResource{}
+
+func NewSnmpResource() resource.Resource {
+	return &SnmpResource{}
+}
+
+type SnmpResource struct {
+	client *api.Client
+}
+
+type SnmpResourceModel struct {
+	Id               types.String    `tfsdk:"id"`
+	Enabled          types.Bool      `tfsdk:"enabled"`
+	Location         types.String    `tfsdk:"location"`
+	Contact          types.String    `tfsdk:"contact"`
+	Communities      types.Set       `tfsdk:"communities"`
+	TrapDestinations types.Set       `tfsdk:"trap_destinations"`
+	Users            []SnmpUserModel `tfsdk:"users"`
+}
+
+type SnmpUserModel struct {
+	Name            types.String `tfsdk:"name"`
+	AuthProtocol    types.String `tfsdk:"auth_protocol"`
+	AuthPassword    types.String `tfsdk:"auth_password"`
+	PrivProtocol    types.String `tfsdk:"priv_protocol"`
+	PrivPassword    types.String `tfsdk:"priv_password"`
+	PasswordVersion types.Int32  `tfsdk:"password_version"`
+}
+
+func (r *SnmpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snmp"
+}
+
+func (r *SnmpResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the SNMP settings, always `snmp`.")
+}
+
+func (r *SnmpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the SNMP settings of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource disables SNMP and removes all communities, trap destinations and users.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `snmp`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the SNMP agent is enabled.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "The location reported by the SNMP agent.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"contact": schema.StringAttribute{
+				MarkdownDescription: "The contact reported by the SNMP agent.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"communities": schema.SetAttribute{
+				MarkdownDescription: "The SNMP v1/v2c communities allowed to query the agent.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"trap_destinations": schema.SetAttribute{
+				MarkdownDescription: "The addresses of the hosts receiving SNMP traps.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The SNMP v3 users. Users which are not configured are removed.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the user.",
+							Required:            true,
+						},
+						"auth_protocol": schema.StringAttribute{
+							MarkdownDescription: "The authentication protocol, either `MD5` or `SHA`.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"auth_password": schema.StringAttribute{
+							MarkdownDescription: "The authentication password. This value is write-only, it is never stored in the state.",
+							Optional:            true,
+							WriteOnly:           true,
+						},
+						"priv_protocol": schema.StringAttribute{
+							MarkdownDescription: "The privacy protocol, either `DES` or `AES`.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"priv_password": schema.StringAttribute{
+							MarkdownDescription: "The privacy password. This value is write-only, it is never stored in the state.",
+							Optional:            true,
+							WriteOnly:           true,
+						},
+						"password_version": schema.Int32Attribute{
+							MarkdownDescription: "Change this value to send the passwords of the user to the LoadMaster again.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SnmpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SnmpResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destinations, diags := stringSetValues(ctx, data.TrapDestinations)
+	resp.Diagnostics.Append(diags...)
+
+	for _, destination := range destinations {
+		if net.ParseIP(destination) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("trap_destinations"),
+				"Invalid Trap Destination",
+				fmt.Sprintf("The trap destinations must be IP addresses, got: %s", destination),
+			)
+		}
+	}
+
+	names := map[string]bool{}
+	for i, user := range data.Users {
+		if !user.Name.IsUnknown() {
+			if names[user.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("users").AtListIndex(i).AtName("name"),
+					"Duplicate SNMP User",
+					fmt.Sprintf("The user %s is configured more than once", user.Name.ValueString()),
+				)
+			}
+			names[user.Name.ValueString()] = true
+		}
+
+		if !user.AuthProtocol.IsNull() && !user.AuthProtocol.IsUnknown() && user.AuthProtocol.ValueString() != "MD5" && user.AuthProtocol.ValueString() != "SHA" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("users").AtListIndex(i).AtName("auth_protocol"),
+				"Invalid Authentication Protocol",
+				fmt.Sprintf("The authentication protocol must be either `MD5` or `SHA`, got: %s", user.AuthProtocol.ValueString()),
+			)
+		}
+
+		if !user.PrivProtocol.IsNull() && !user.PrivProtocol.IsUnknown() && user.PrivProtocol.ValueString() != "DES" && user.PrivProtocol.ValueString() != "AES" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("users").AtListIndex(i).AtName("priv_protocol"),
+				"Invalid Privacy Protocol",
+				fmt.Sprintf("The privacy protocol must be either `DES` or `AES`, got: %s", user.PrivProtocol.ValueString()),
+			)
+		}
+
+		if !user.PrivPassword.IsNull() && user.AuthPassword.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("users").AtListIndex(i).AtName("priv_password"),
+				"Missing Authentication Password",
+				"A privacy password can only be used together with an authentication password.",
+			)
+		}
+	}
+}
+
+func (r *SnmpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SnmpResourceModel
+	var config SnmpResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	resp.Diagnostics.Append(r.apply(ctx, data, config, nil)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	tflog.Trace(ctx, "created a resource snmp")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SnmpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SnmpResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SnmpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SnmpResourceModel
+	var config SnmpResourceModel
+	var state SnmpResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, config, state.Users)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SnmpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SnmpResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, user := range data.Users {
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			return r.client.DeleteSnmpUser(user.Name.ValueString())
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete snmp user %s, got error: %s", user.Name.ValueString(), err))
+			return
+		}
+	}
+
+	operation := ClientBackoff(func() (*api.SnmpResponse, error) {
+		return r.client.ModifySnmp(api.SnmpParameters{
+			Enable:           bool2ptr(false),
+			Communities:      []string{},
+			TrapDestinations: []string{},
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset snmp, got error: %s", err))
+		return
+	}
+}
+
+func (r *SnmpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data SnmpResourceModel
+
+	data.Users = []SnmpUserModel{}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.Users) == 0 {
+		data.Users = nil
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+// apply sends the settings and reconciles the users. The passwords are taken
+// from the configuration, as write-only values are never part of the plan.
+func (r *SnmpResource) apply(ctx context.Context, plan SnmpResourceModel, config SnmpResourceModel, current []SnmpUserModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	communities, d := stringSetValues(ctx, plan.Communities)
+	diags.Append(d...)
+	destinations, d := stringSetValues(ctx, plan.TrapDestinations)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	operation := ClientBackoff(func() (*api.SnmpResponse, error) {
+		return r.client.ModifySnmp(api.SnmpParameters{
+			Enable:           plan.Enabled.ValueBoolPointer(),
+			Location:         plan.Location.ValueString(),
+			Contact:          plan.Contact.ValueString(),
+			Communities:      communities,
+			TrapDestinations: destinations,
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to modify snmp, got error: %s", err))
+		return diags
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	// Users are only managed when they are configured.
+	if plan.Users == nil {
+		return diags
+	}
+
+	existing := map[string]api.SnmpUser{}
+	for _, user := range response.Users {
+		existing[user.Name] = user
+	}
+
+	versions := map[string]types.Int32{}
+	for _, user := range current {
+		versions[user.Name.ValueString()] = user.PasswordVersion
+	}
+
+	desired := map[string]bool{}
+	for i, user := range plan.Users {
+		name := user.Name.ValueString()
+		desired[name] = true
+
+		snmpUser := api.SnmpUser{
+			Name:         name,
+			AuthProtocol: user.AuthProtocol.ValueString(),
+			PrivProtocol: user.PrivProtocol.ValueString(),
+		}
+		if i < len(config.Users) {
+			snmpUser.AuthPassword = config.Users[i].AuthPassword.ValueString()
+			snmpUser.PrivPassword = config.Users[i].PrivPassword.ValueString()
+		}
+
+		remote, ok := existing[name]
+		if ok && unchangedSnmpUser(user, remote, versions[name]) {
+			continue
+		}
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			if ok {
+				return r.client.ModifySnmpUser(snmpUser)
+			}
+
+			return r.client.AddSnmpUser(snmpUser)
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to modify snmp user %s, got error: %s", name, err))
+			return diags
+		}
+	}
+
+	for name := range existing {
+		if desired[name] {
+			continue
+		}
+
+		operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+			return r.client.DeleteSnmpUser(name)
+		})
+		_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete snmp user %s, got error: %s", name, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// refresh reads the settings and, if they are managed, the users. Users keep
+// the order of the state, users which were added outside of Terraform are
+// appended.
+func (r *SnmpResource) refresh(ctx context.Context, data *SnmpResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	operation := ClientBackoff(func() (*api.SnmpResponse, error) {
+		return r.client.ShowSnmp()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read snmp, got error: %s", err))
+		return diags
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	communities, d := stringSetValue(ctx, response.Communities)
+	diags.Append(d...)
+	destinations, d := stringSetValue(ctx, response.TrapDestinations)
+	diags.Append(d...)
+
+	remote := map[string]api.SnmpUser{}
+	for _, user := range response.Users {
+		remote[user.Name] = user
+	}
+
+	var users []SnmpUserModel
+	if data.Users != nil {
+		users = []SnmpUserModel{}
+	}
+
+	for _, user := range data.Users {
+		snmpUser, ok := remote[user.Name.ValueString()]
+		if !ok {
+			continue
+		}
+
+		users = append(users, snmpUserModel(snmpUser, user.PasswordVersion))
+		delete(remote, snmpUser.Name)
+	}
+
+	for _, snmpUser := range response.Users {
+		if _, ok := remote[snmpUser.Name]; ok && users != nil {
+			users = append(users, snmpUserModel(snmpUser, types.Int32Null()))
+		}
+	}
+
+	data.Id = types.StringValue("snmp")
+	data.Enabled = types.BoolPointerValue(response.Enable)
+	data.Location = types.StringValue(response.Location)
+	data.Contact = types.StringValue(response.Contact)
+	data.Communities = communities
+	data.TrapDestinations = destinations
+	data.Users = users
+
+	return diags
+}
+
+// unchangedSnmpUser reports whether the user on the LoadMaster matches the
+// plan and the passwords were not rotated.
+func unchangedSnmpUser(user SnmpUserModel, remote api.SnmpUser, version types.Int32) bool {
+	if !user.AuthProtocol.IsUnknown() && user.AuthProtocol.ValueString() != remote.AuthProtocol {
+		return false
+	}
+
+	if !user.PrivProtocol.IsUnknown() && user.PrivProtocol.ValueString() != remote.PrivProtocol {
+		return false
+	}
+
+	return user.PasswordVersion.Equal(version)
+}
+
+func snmpUserModel(user api.SnmpUser, version types.Int32) SnmpUserModel {
+	return SnmpUserModel{
+		Name:            types.StringValue(user.Name),
+		AuthProtocol:    types.StringValue(user.AuthProtocol),
+		AuthPassword:    types.StringNull(),
+		PrivProtocol:    types.StringValue(user.PrivProtocol),
+		PrivPassword:    types.StringNull(),
+		PasswordVersion: version,
+	}
+}
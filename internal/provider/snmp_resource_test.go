@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestSnmpResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testSnmpResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("snmp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("users").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact("monitor"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("users").AtSliceIndex(0).AtMapKey("auth_password"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_snmp.test",
+				ImportState:             true,
+				ImportStateId:           "snmp",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"users.0.password_version"},
+			},
+			{
+				Config: testSnmpResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("location"),
+						knownvalue.StringExact("Datacenter Bern"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_snmp.test",
+						tfjsonpath.New("users"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testSnmpResourceConfig = `
+resource "loadmaster_snmp" "test" {
+  enabled = true
+  location = "Datacenter Zurich"
+  contact = "noc@example.com"
+  communities = ["monitoring"]
+  trap_destinations = ["192.0.2.20"]
+
+  users = [
+    {
+      name = "monitor"
+      auth_protocol = "SHA"
+      auth_password = "authpassword"
+      priv_protocol = "AES"
+      priv_password = "privpassword"
+      password_version = 1
+    },
+  ]
+}
+`
+
+const testSnmpResourceConfigUpdate = `
+resource "loadmaster_snmp" "test" {
+  enabled = true
+  location = "Datacenter Bern"
+  contact = "noc@example.com"
+  communities = ["monitoring"]
+  trap_destinations = ["192.0.2.20"]
+  users = []
+}
+`
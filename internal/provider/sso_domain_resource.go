@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &SSODomainResource{}
+var _ resource.ResourceWithImportState = &SSODomainResource{}
+var _ resource.ResourceWithValidateConfig = &SSODomainResource{}
+var _ resource.ResourceWithModifyPlan = &SSODomainResource{}
+var _ resource.ResourceWithIdentity = &SSODomainResource{}
+
+func NewSSODomainResource() resource.Resource {
+	return &SSODomainResource{}
+}
+
+type SSODomainResource struct {
+	client *api.Client
+}
+
+type SSODomainResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Side                    types.String `tfsdk:"side"`
+	AuthProtocol            types.String `tfsdk:"auth_protocol"`
+	LdapEndpoint            types.String `tfsdk:"ldap_endpoint"`
+	LogonFormat             types.String `tfsdk:"logon_format"`
+	LogonDomain             types.String `tfsdk:"logon_domain"`
+	IdleTimeout             types.Int32  `tfsdk:"idle_timeout"`
+	MaxSessionDuration      types.Int32  `tfsdk:"max_session_duration"`
+	SamlIdpMetadata         types.String `tfsdk:"saml_idp_metadata"`
+	SamlIdpEntityId         types.String `tfsdk:"saml_idp_entity_id"`
+	SamlSpEntityId          types.String `tfsdk:"saml_sp_entity_id"`
+	KerberosRealm           types.String `tfsdk:"kerberos_realm"`
+	KerberosKdc             types.String `tfsdk:"kerberos_kdc"`
+	KerberosTrustedUser     types.String `tfsdk:"kerberos_trusted_user"`
+	KerberosPassword        types.String `tfsdk:"kerberos_password"`
+	KerberosPasswordVersion types.Int32  `tfsdk:"kerberos_password_version"`
+}
+
+func (r *SSODomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_domain"
+}
+
+func (r *SSODomainResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("name", "The name of the SSO domain.")
+}
+
+func (r *SSODomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a single sign-on domain of the Edge Security Pack. Client side domains authenticate users connecting to a virtual service, server side domains authenticate the LoadMaster against the real servers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the domain, same as `name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the domain.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"side": schema.StringAttribute{
+				MarkdownDescription: "Whether the domain is used on the `client` or the `server` side of a virtual service.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_protocol": schema.StringAttribute{
+				MarkdownDescription: "The authentication protocol. One of `ldap`, `radius`, `saml` or `certificate` for client side domains and `kcd` (Kerberos constrained delegation) for server side domains.",
+				Required:            true,
+			},
+			"ldap_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The name of the LDAP endpoint used by the `ldap` protocol.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"logon_format": schema.StringAttribute{
+				MarkdownDescription: "The format of the username passed to the authentication server. One of `not_specified`, `principal_name`, `username` or `username_only`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"logon_domain": schema.StringAttribute{
+				MarkdownDescription: "The domain added to the username, depending on the `logon_format`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"idle_timeout": schema.Int32Attribute{
+				MarkdownDescription: "The time in seconds after which an idle session is closed, between 60 and 604800.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max_session_duration": schema.Int32Attribute{
+				MarkdownDescription: "The maximum duration of a session in seconds, between 60 and 604800.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"saml_idp_metadata": schema.StringAttribute{
+				MarkdownDescription: "The metadata XML of the SAML identity provider. The LoadMaster does not return the metadata, changes made outside of Terraform are not detected.",
+				Optional:            true,
+			},
+			"saml_idp_entity_id": schema.StringAttribute{
+				MarkdownDescription: "The entity id of the SAML identity provider, as read from the metadata.",
+				Computed:            true,
+			},
+			"saml_sp_entity_id": schema.StringAttribute{
+				MarkdownDescription: "The entity id of the LoadMaster as SAML service provider.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"kerberos_realm": schema.StringAttribute{
+				MarkdownDescription: "The Kerberos realm used by the `kcd` protocol.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"kerberos_kdc": schema.StringAttribute{
+				MarkdownDescription: "The Kerberos key distribution center used by the `kcd` protocol.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"kerberos_trusted_user": schema.StringAttribute{
+				MarkdownDescription: "The user trusted for delegation used by the `kcd` protocol.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"kerberos_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the `kerberos_trusted_user`. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"kerberos_password_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `kerberos_password` to the LoadMaster again.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SSODomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SSODomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only new resources are checked, existing ones were accepted by the LoadMaster.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(requireLicensedFeature(ctx, r.client, "esp", path.Root("name"))...)
+}
+
+func (r *SSODomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SSODomainResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	side := "client"
+	if !data.Side.IsNull() && !data.Side.IsUnknown() {
+		side = data.Side.ValueString()
+		if side != "client" && side != "server" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("side"),
+				"Invalid SSO Domain Side",
+				fmt.Sprintf("The side must be either `client` or `server`, got: %s", side),
+			)
+		}
+	}
+
+	required := func(attribute string, value types.String, protocol string) {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing SSO Domain Attribute",
+				fmt.Sprintf("The attribute %s is required for the %s protocol.", attribute, protocol),
+			)
+		}
+	}
+
+	if !data.AuthProtocol.IsUnknown() {
+		switch protocol := data.AuthProtocol.ValueString(); protocol {
+		case "ldap":
+			required("ldap_endpoint", data.LdapEndpoint, protocol)
+		case "saml":
+			required("saml_idp_metadata", data.SamlIdpMetadata, protocol)
+		case "radius", "certificate":
+		case "kcd":
+			required("kerberos_realm", data.KerberosRealm, protocol)
+			required("kerberos_kdc", data.KerberosKdc, protocol)
+			required("kerberos_trusted_user", data.KerberosTrustedUser, protocol)
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_protocol"),
+				"Invalid Authentication Protocol",
+				fmt.Sprintf("The protocol must be one of `ldap`, `radius`, `saml`, `certificate` or `kcd`, got: %s", protocol),
+			)
+		}
+
+		if protocol := data.AuthProtocol.ValueString(); (protocol == "kcd") != (side == "server") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("auth_protocol"),
+				"Invalid Authentication Protocol",
+				fmt.Sprintf("The protocol %s cannot be used by a %s side domain, `kcd` is only available on the server side.", protocol, side),
+			)
+		}
+	}
+
+	if !data.LogonFormat.IsNull() && !data.LogonFormat.IsUnknown() {
+		switch data.LogonFormat.ValueString() {
+		case "not_specified", "principal_name", "username", "username_only":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("logon_format"),
+				"Invalid Logon Format",
+				fmt.Sprintf("The logon format must be one of `not_specified`, `principal_name`, `username` or `username_only`, got: %s", data.LogonFormat.ValueString()),
+			)
+		}
+	}
+
+	for name, value := range map[string]types.Int32{"idle_timeout": data.IdleTimeout, "max_session_duration": data.MaxSessionDuration} {
+		if !value.IsNull() && !value.IsUnknown() && (value.ValueInt32() < 60 || value.ValueInt32() > 604800) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Session Timeout",
+				fmt.Sprintf("The timeout must be between 60 and 604800 seconds, got: %d", value.ValueInt32()),
+			)
+		}
+	}
+}
+
+func (r *SSODomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SSODomainResourceModel
+	var config SSODomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "name", data.Name)
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters := r.parameters(data)
+	parameters.SamlIdpMetadata = data.SamlIdpMetadata.ValueStringPointer()
+	parameters.KerberosPassword = config.KerberosPassword.ValueStringPointer()
+
+	operation := ClientBackoff(func() (*api.SSODomainResponse, error) {
+		return r.client.AddSSODomain(data.Name.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sso domain, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource sso domain")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *SSODomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SSODomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.SSODomainResponse, error) {
+		return r.client.ShowSSODomain(data.Name.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Message == "Unknown domain" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sso domain, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *SSODomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SSODomainResourceModel
+	var config SSODomainResourceModel
+	var state SSODomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parameters(data)
+
+	// The metadata cannot be read back, it is only uploaded when it changes.
+	if !data.SamlIdpMetadata.Equal(state.SamlIdpMetadata) {
+		parameters.SamlIdpMetadata = data.SamlIdpMetadata.ValueStringPointer()
+	}
+
+	// The password is only sent again when its version changes.
+	if !data.KerberosPasswordVersion.Equal(state.KerberosPasswordVersion) {
+		parameters.KerberosPassword = config.KerberosPassword.ValueStringPointer()
+	}
+
+	operation := ClientBackoff(func() (*api.SSODomainResponse, error) {
+		return r.client.ModifySSODomain(data.Name.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sso domain, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSODomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SSODomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteSSODomain(data.Name.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sso domain, got error: %s", err))
+		return
+	}
+}
+
+func (r *SSODomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data SSODomainResourceModel
+
+	name := importAttribute(ctx, req, "name", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.SSODomainResponse, error) {
+		return r.client.ShowSSODomain(name)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sso domain for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Name = types.StringValue(name)
+	data.SamlIdpMetadata = types.StringNull()
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("name"), data.Name)...)
+}
+
+func (r *SSODomainResource) parameters(data SSODomainResourceModel) api.SSODomainParameters {
+	return api.SSODomainParameters{
+		Side:                data.Side.ValueString(),
+		AuthProtocol:        data.AuthProtocol.ValueString(),
+		LdapEndpoint:        data.LdapEndpoint.ValueString(),
+		LogonFormat:         data.LogonFormat.ValueString(),
+		LogonDomain:         data.LogonDomain.ValueString(),
+		IdleTimeout:         data.IdleTimeout.ValueInt32Pointer(),
+		MaxDuration:         data.MaxSessionDuration.ValueInt32Pointer(),
+		SamlSpEntityId:      data.SamlSpEntityId.ValueString(),
+		KerberosRealm:       data.KerberosRealm.ValueString(),
+		KerberosKdc:         data.KerberosKdc.ValueString(),
+		KerberosTrustedUser: data.KerberosTrustedUser.ValueString(),
+	}
+}
+
+func (r *SSODomainResource) fromResponse(data *SSODomainResourceModel, response *api.SSODomainResponse) {
+	data.Id = types.StringValue(data.Name.ValueString())
+	data.Side = types.StringValue(response.Side)
+	data.AuthProtocol = types.StringValue(response.AuthProtocol)
+	data.LdapEndpoint = types.StringValue(response.LdapEndpoint)
+	data.LogonFormat = types.StringValue(response.LogonFormat)
+	data.LogonDomain = types.StringValue(response.LogonDomain)
+	data.IdleTimeout = types.Int32Value(response.IdleTimeout)
+	data.MaxSessionDuration = types.Int32Value(response.MaxDuration)
+	data.SamlIdpEntityId = types.StringValue(response.SamlIdpEntityId)
+	data.SamlSpEntityId = types.StringValue(response.SamlSpEntityId)
+	data.KerberosRealm = types.StringValue(response.KerberosRealm)
+	data.KerberosKdc = types.StringValue(response.KerberosKdc)
+	data.KerberosTrustedUser = types.StringValue(response.KerberosTrustedUser)
+	data.KerberosPassword = types.StringNull()
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestSSODomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testSSODomainResourceConfigInvalid,
+				ExpectError: regexp.MustCompile("Missing SSO Domain Attribute"),
+			},
+			// Create and Read testing
+			{
+				Config: testSSODomainResourceConfig(3600),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_sso_domain.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("tfsso"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_sso_domain.test",
+						tfjsonpath.New("side"),
+						knownvalue.StringExact("client"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_sso_domain.test",
+						tfjsonpath.New("ldap_endpoint"),
+						knownvalue.StringExact("tfsso"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_sso_domain.test",
+				ImportState:       true,
+				ImportStateId:     "tfsso",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testSSODomainResourceConfig(900),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_sso_domain.test",
+						tfjsonpath.New("idle_timeout"),
+						knownvalue.Int32Exact(900),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testSSODomainResourceConfig(idleTimeout int) string {
+	return fmt.Sprintf(`
+resource "loadmaster_ldap_endpoint" "test" {
+  name = "tfsso"
+  servers = ["192.0.2.30"]
+}
+
+resource "loadmaster_sso_domain" "test" {
+  name = "tfsso"
+  auth_protocol = "ldap"
+  ldap_endpoint = loadmaster_ldap_endpoint.test.name
+  logon_format = "principal_name"
+  logon_domain = "example.com"
+  idle_timeout = %d
+}
+`, idleTimeout)
+}
+
+const testSSODomainResourceConfigInvalid = `
+resource "loadmaster_sso_domain" "test" {
+  name = "tfsso"
+  auth_protocol = "saml"
+}
+`
@@ -9,16 +9,20 @@ import (
 	"strconv"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kreemer/loadmaster-go-client/api"
 )
 
 var (
-	_ datasource.DataSource              = &SubVirtualServiceDataSource{}
-	_ datasource.DataSourceWithConfigure = &SubVirtualServiceDataSource{}
+	_ datasource.DataSource                     = &SubVirtualServiceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &SubVirtualServiceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &SubVirtualServiceDataSource{}
 )
 
 func NewSubVirtualServiceDataSource() datasource.DataSource {
@@ -42,15 +46,17 @@ func (d *SubVirtualServiceDataSource) Metadata(ctx context.Context, req datasour
 
 func (d *SubVirtualServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Use this data source to retrieve information about a sub virtual service.",
+		MarkdownDescription: "Use this data source to retrieve information about a sub virtual service. The sub virtual service is looked up by exactly one of `id` or `nickname`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The sub virtual service id. This is also called `Index` in the LoadMaster API.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"virtual_service_id": schema.StringAttribute{
-				MarkdownDescription: "The id of the virtual service. This is also called `Index` in the LoadMaster API.",
+				MarkdownDescription: "The id of the virtual service. This is also called `Index` in the LoadMaster API. Can be set to restrict a lookup by `nickname` to the sub virtual services of this virtual service.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"type": schema.StringAttribute{
@@ -58,7 +64,8 @@ func (d *SubVirtualServiceDataSource) Schema(ctx context.Context, req datasource
 				Computed:            true,
 			},
 			"nickname": schema.StringAttribute{
-				MarkdownDescription: "The nickname of the sub virtual service.",
+				MarkdownDescription: "The nickname of the sub virtual service. The lookup fails if several sub virtual services have the nickname.",
+				Optional:            true,
 				Computed:            true,
 			},
 		},
@@ -85,6 +92,20 @@ func (d *SubVirtualServiceDataSource) Configure(ctx context.Context, req datasou
 	d.client = client
 }
 
+func (d *SubVirtualServiceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("nickname"),
+		),
+		// The virtual service id only restricts a lookup by nickname.
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("virtual_service_id"),
+		),
+	}
+}
+
 func (d *SubVirtualServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data SubVirtualServiceDataSourceModel
 
@@ -95,6 +116,24 @@ func (d *SubVirtualServiceDataSource) Read(ctx context.Context, req datasource.R
 	}
 
 	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		nickname := data.Nickname.ValueString()
+		description := fmt.Sprintf("the nickname %q", nickname)
+		if !data.VirtualServiceId.IsNull() {
+			description += " in virtual service " + data.VirtualServiceId.ValueString()
+		}
+
+		var diags diag.Diagnostics
+		id, diags = lookupVirtualService(ctx, d.client, "sub virtual service", description, func(vs api.VirtualServiceResponse) bool {
+			return vs.MasterVSID != 0 && vs.NickName == nickname &&
+				(data.VirtualServiceId.IsNull() || data.VirtualServiceId.ValueString() == strconv.Itoa(int(vs.MasterVSID)))
+		})
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	operation := ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
 		return d.client.ShowSubVirtualService(id)
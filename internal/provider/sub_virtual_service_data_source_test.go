@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -55,3 +56,50 @@ data "loadmaster_sub_virtual_service" "test" {
   id = loadmaster_sub_virtual_service.example.id
 }
 `
+
+func TestSubVirtualServiceDataSourceLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testSubVirtualServiceDataSourceLookupConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_sub_virtual_service.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("http2"),
+					),
+				},
+			},
+			{
+				Config: `
+data "loadmaster_sub_virtual_service" "test" {}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+const testSubVirtualServiceDataSourceLookupConfig = `
+resource "loadmaster_virtual_service" "example" {
+	address = "10.0.0.4"
+	port = "9090"
+	protocol = "tcp"
+}
+
+resource "loadmaster_sub_virtual_service" "example" {
+  virtual_service_id = loadmaster_virtual_service.example.id
+
+  nickname = "subvs-lookup"
+  type = "http2"
+}
+
+data "loadmaster_sub_virtual_service" "test" {
+  virtual_service_id = loadmaster_virtual_service.example.id
+  nickname           = "subvs-lookup"
+
+  depends_on = [loadmaster_sub_virtual_service.example]
+}
+`
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ list.ListResource = &SubVirtualServiceListResource{}
+var _ list.ListResourceWithConfigure = &SubVirtualServiceListResource{}
+
+func NewSubVirtualServiceListResource() list.ListResource {
+	return &SubVirtualServiceListResource{}
+}
+
+type SubVirtualServiceListResource struct {
+	client *api.Client
+}
+
+type SubVirtualServiceListResourceModel struct {
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	Nickname         types.String `tfsdk:"nickname"`
+	Type             types.String `tfsdk:"type"`
+}
+
+func (r *SubVirtualServiceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sub_virtual_service"
+}
+
+func (r *SubVirtualServiceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the sub virtual services of the LoadMaster.",
+
+		Attributes: map[string]schema.Attribute{
+			"virtual_service_id": schema.StringAttribute{
+				MarkdownDescription: "Only list sub virtual services of this parent virtual service.",
+				Optional:            true,
+			},
+			"nickname": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the nickname must match.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list sub virtual services of this type.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SubVirtualServiceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubVirtualServiceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data SubVirtualServiceListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	nickname, d := listFilterRegexp(data.Nickname, "nickname")
+	diags.Append(d...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+		return r.client.ListVirtualServices()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list sub virtual services, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, vs := range response.VS {
+			parent := strconv.Itoa(int(vs.MasterVSID))
+			if vs.MasterVSID == 0 || !nickname.MatchString(vs.NickName) ||
+				!listFilterMatches(data.VirtualServiceId, parent) || !listFilterMatches(data.Type, vs.VSType) {
+				continue
+			}
+
+			if listLimitReached(req, count) {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s (%s)", vs.NickName, parent)
+
+			id := types.StringValue(strconv.Itoa(int(vs.Index)))
+			result.Diagnostics.Append(result.Identity.Set(ctx, IdIdentityModel{Id: id})...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, SubVirtualServiceResourceModel{
+					Id:               id,
+					VirtualServiceId: types.StringValue(parent),
+					Type:             types.StringValue(vs.VSType),
+					Nickname:         types.StringValue(vs.NickName),
+
+					DeletionProtection: types.BoolValue(false),
+				})...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -20,13 +20,16 @@ import (
 
 var _ resource.Resource = &SubVirtualServiceResource{}
 var _ resource.ResourceWithImportState = &SubVirtualServiceResource{}
+var _ resource.ResourceWithIdentity = &SubVirtualServiceResource{}
+var _ resource.ResourceWithModifyPlan = &SubVirtualServiceResource{}
 
 func NewSubVirtualServiceResource() resource.Resource {
 	return &SubVirtualServiceResource{}
 }
 
 type SubVirtualServiceResource struct {
-	client *api.Client
+	client         *api.Client
+	failCreateStep createFault
 }
 
 type SubVirtualServiceResourceModel struct {
@@ -34,12 +37,18 @@ type SubVirtualServiceResourceModel struct {
 	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
 	Type             types.String `tfsdk:"type"`
 	Nickname         types.String `tfsdk:"nickname"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 func (r *SubVirtualServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sub_virtual_service"
 }
 
+func (r *SubVirtualServiceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the sub virtual service.")
+}
+
 func (r *SubVirtualServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a sub virtual service.",
@@ -60,15 +69,17 @@ func (r *SubVirtualServiceResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the sub virtual service, either `gen`, `http`, `http2`, `ts`, `tls` or `log`.",
+				MarkdownDescription: "The type of the sub virtual service, either `gen`, `http`, `http2`, `ts`, `tls` or `log`. Changing the type resets the layer 7 settings of the sub virtual service on the LoadMaster.",
 				Computed:            true,
 				Optional:            true,
+				Validators:          oneOf(virtualServiceTypes...),
 			},
 			"nickname": schema.StringAttribute{
 				MarkdownDescription: "The nickname of the sub virtual service.",
 				Computed:            true,
 				Optional:            true,
 			},
+			"deletion_protection": deletionProtectionSchema("sub virtual service"),
 		},
 	}
 }
@@ -91,6 +102,37 @@ func (r *SubVirtualServiceResource) Configure(ctx context.Context, req resource.
 	r.client = client
 }
 
+func (r *SubVirtualServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "sub virtual service")
+
+	if req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state SubVirtualServiceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(warnVirtualServiceTypeChange(state.Type, plan.Type)...)
+
+	if plan.Type.IsUnknown() || plan.Type.Equal(state.Type) || !isHttpVirtualServiceType(plan.Type.ValueString()) {
+		return
+	}
+
+	// Sub virtual services share the protocol of their virtual service.
+	if vs := showPlannedVirtualService(ctx, r.client, plan.VirtualServiceId); vs != nil {
+		resp.Diagnostics.Append(validateVirtualServiceProtocol(types.StringValue(vs.Protocol), plan.Type)...)
+	}
+}
+
 func (r *SubVirtualServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SubVirtualServiceResourceModel
 
@@ -103,9 +145,9 @@ func (r *SubVirtualServiceResource) Create(ctx context.Context, req resource.Cre
 	ctx = tflog.SetField(ctx, "virtual_service_id", data.VirtualServiceId)
 	tflog.Debug(ctx, "creating a resource")
 
-	operation := ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
+	operation := createStep(r.failCreateStep, "add sub virtual service", ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
 		return r.client.AddSubVirtualService(data.VirtualServiceId.ValueString(), api.VirtualServiceParameters{})
-	})
+	}))
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 
 	if err != nil {
@@ -115,20 +157,44 @@ func (r *SubVirtualServiceResource) Create(ctx context.Context, req resource.Cre
 	tflog.SetField(ctx, "response", response)
 	tflog.Trace(ctx, "Received valid response from API")
 
-	data.Id = types.StringValue(strconv.Itoa(int(response.SubVS[len(response.SubVS)-1].VSIndex)))
+	id := strconv.Itoa(int(response.SubVS[len(response.SubVS)-1].VSIndex))
+	data.Id = types.StringValue(id)
 
-	operation = ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
-		return r.client.ModifySubVirtualService(data.Id.ValueString(), api.VirtualServiceParameters{
+	// The sub virtual service exists from here on, it is removed again if
+	// configuring it fails.
+	var rollback createRollback
+	rollback.add(fmt.Sprintf("sub virtual service %s", id), func(ctx context.Context) error {
+		if err := r.failCreateStep.check("rollback sub virtual service"); err != nil {
+			return err
+		}
+
+		return r.delete(ctx, id)
+	})
+
+	operation = createStep(r.failCreateStep, "modify sub virtual service", ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
+		return r.client.ModifySubVirtualService(id, api.VirtualServiceParameters{
 			VirtualServiceParametersBasicProperties: &api.VirtualServiceParametersBasicProperties{
 				VSType:   data.Type.ValueString(),
 				NickName: data.Nickname.ValueString(),
 			},
 		})
-	})
+	}))
 	response, err = backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sub virtual service, got error: %s", err))
+
+		if !rollback.run(ctx, &resp.Diagnostics) {
+			// The settings of the sub virtual service are unknown, Terraform
+			// replaces the tainted resource on the next apply. Deletion
+			// protection must not block removing the orphan.
+			data.Type = types.StringNull()
+			data.Nickname = types.StringNull()
+			data.DeletionProtection = types.BoolValue(false)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+		}
+
 		return
 	}
 	data.Type = types.StringValue(response.VSType)
@@ -137,6 +203,7 @@ func (r *SubVirtualServiceResource) Create(ctx context.Context, req resource.Cre
 	tflog.Trace(ctx, "created a resource sub virtual service")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *SubVirtualServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -173,6 +240,7 @@ func (r *SubVirtualServiceResource) Read(ctx context.Context, req resource.ReadR
 	data.VirtualServiceId = types.StringValue(strconv.Itoa(int(response.MasterVSID)))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *SubVirtualServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -218,21 +286,32 @@ func (r *SubVirtualServiceResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	id := data.Id.ValueString()
-	operation := ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
-		return r.client.ShowSubVirtualService(id)
-	})
-	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sub virtual service, got error: %s", err))
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("sub virtual service")...)
+		return
+	}
+
+	if err := r.delete(ctx, data.Id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sub virtual service, got error: %s", err))
 		return
 	}
 }
 
+// delete removes the sub virtual service, the LoadMaster deletes sub virtual
+// services like virtual services.
+func (r *SubVirtualServiceResource) delete(ctx context.Context, id string) error {
+	operation := ClientBackoff(func() (*api.DeleteVirtualServiceResponse, error) {
+		return r.client.DeleteVirtualService(id)
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	return err
+}
+
 func (r *SubVirtualServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data SubVirtualServiceResourceModel
 
-	id := req.ID
+	id := importId(ctx, req, &resp.Diagnostics)
 	operation := ClientBackoff(func() (*api.ShowSubVirtualServiceResponse, error) {
 		return r.client.ShowSubVirtualService(id)
 	})
@@ -252,6 +331,8 @@ func (r *SubVirtualServiceResource) ImportState(ctx context.Context, req resourc
 	data.Type = types.StringValue(response.VSType)
 	data.Nickname = types.StringValue(response.NickName)
 	data.VirtualServiceId = types.StringValue(strconv.Itoa(int(response.MasterVSID)))
+	data.DeletionProtection = types.BoolValue(false)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
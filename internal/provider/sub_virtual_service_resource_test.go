@@ -5,12 +5,17 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cenkalti/backoff/v5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
 )
 
 func TestSubVirtualServiceResource(t *testing.T) {
@@ -58,6 +63,77 @@ func TestSubVirtualServiceResource(t *testing.T) {
 	})
 }
 
+func TestSubVirtualServiceResourceRollback(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesFailingCreateSteps("modify sub virtual service"),
+				Config:                   testSubVirtualServiceResourceConfig("rollback"),
+				ExpectError:              regexp.MustCompile("injected failure"),
+			},
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   testSubVirtualServiceResourceConfigParent(),
+				Check:                    testCheckNoSubVirtualServices(t, "loadmaster_virtual_service.test"),
+			},
+		},
+	})
+}
+
+func TestSubVirtualServiceResourcePartialState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesFailingCreateSteps("modify sub virtual service", "rollback sub virtual service"),
+				Config:                   testSubVirtualServiceResourceConfig("partial"),
+				ExpectError:              regexp.MustCompile("Rollback Failed"),
+			},
+			// The orphan is tracked in the state and replaced once the
+			// LoadMaster accepts the changes again.
+			{
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Config:                   testSubVirtualServiceResourceConfig("partial"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_sub_virtual_service.test",
+						tfjsonpath.New("nickname"),
+						knownvalue.StringExact("partial"),
+					),
+				},
+			},
+		},
+	})
+}
+
+// testCheckNoSubVirtualServices checks that the virtual service has no sub
+// virtual services on the LoadMaster.
+func testCheckNoSubVirtualServices(t *testing.T, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+
+		client := api.NewClientWithApiKey(os.Getenv("LOADMASTER_HOST"), os.Getenv("LOADMASTER_API_KEY"))
+
+		operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
+			return client.ShowVirtualService(rs.Primary.ID)
+		})
+		response, err := backoff.Retry(t.Context(), operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+		if err != nil {
+			return err
+		}
+
+		if len(response.SubVS) != 0 {
+			return fmt.Errorf("expected no sub virtual services, got %d", len(response.SubVS))
+		}
+
+		return nil
+	}
+}
+
 func testSubVirtualServiceResourceConfig(nickname string) string {
 	return fmt.Sprintf(`
 resource "loadmaster_virtual_service" "test" {
@@ -72,3 +148,13 @@ resource "loadmaster_sub_virtual_service" "test" {
 }
 `, nickname)
 }
+
+func testSubVirtualServiceResourceConfigParent() string {
+	return `
+resource "loadmaster_virtual_service" "test" {
+  address = "10.0.0.4"
+  port = "9090"
+  protocol = "tcp"
+}
+`
+}
@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &SyslogResource{}
+var _ resource.ResourceWithImportState = &SyslogResource{}
+var _ resource.ResourceWithValidateConfig = &SyslogResource{}
+var _ resource.ResourceWithIdentity = &SyslogResource{}
+
+func NewSyslogResource() resource.Resource {
+	return &SyslogResource{}
+}
+
+type SyslogResource struct {
+	client *api.Client
+}
+
+type SyslogResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	EmergencyHosts types.Set    `tfsdk:"emergency_hosts"`
+	CriticalHosts  types.Set    `tfsdk:"critical_hosts"`
+	ErrorHosts     types.Set    `tfsdk:"error_hosts"`
+	WarningHosts   types.Set    `tfsdk:"warning_hosts"`
+	NoticeHosts    types.Set    `tfsdk:"notice_hosts"`
+	InfoHosts      types.Set    `tfsdk:"info_hosts"`
+	Port           types.Int32  `tfsdk:"port"`
+	Transport      types.String `tfsdk:"transport"`
+}
+
+func (r *SyslogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_syslog"
+}
+
+func (r *SyslogResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the syslog settings, always `syslog`.")
+}
+
+func (r *SyslogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	hosts := func(severity string) schema.SetAttribute {
+		return schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("The addresses of the remote hosts receiving messages of severity `%s` and above.", severity),
+			ElementType:         types.StringType,
+			Optional:            true,
+			Computed:            true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the remote syslog settings of the LoadMaster.\n\nThis resource is a singleton, only one instance should exist per LoadMaster. Destroying the resource removes all remote hosts.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `syslog`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"emergency_hosts": hosts("emergency"),
+			"critical_hosts":  hosts("critical"),
+			"error_hosts":     hosts("error"),
+			"warning_hosts":   hosts("warning"),
+			"notice_hosts":    hosts("notice"),
+			"info_hosts":      hosts("info"),
+			"port": schema.Int32Attribute{
+				MarkdownDescription: "The port of the remote hosts.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"transport": schema.StringAttribute{
+				MarkdownDescription: "The transport used to send the messages. One of `udp`, `tcp` or `tls`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SyslogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SyslogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SyslogResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, set := range map[string]types.Set{
+		"emergency_hosts": data.EmergencyHosts,
+		"critical_hosts":  data.CriticalHosts,
+		"error_hosts":     data.ErrorHosts,
+		"warning_hosts":   data.WarningHosts,
+		"notice_hosts":    data.NoticeHosts,
+		"info_hosts":      data.InfoHosts,
+	} {
+		hosts, diags := stringSetValues(ctx, set)
+		resp.Diagnostics.Append(diags...)
+
+		for _, host := range hosts {
+			if net.ParseIP(host) == nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Invalid Syslog Host",
+					fmt.Sprintf("The syslog hosts must be IP addresses, got: %s", host),
+				)
+			}
+		}
+	}
+
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		if port := data.Port.ValueInt32(); port < 1 || port > 65535 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("port"),
+				"Invalid Syslog Port",
+				fmt.Sprintf("The port must be between 1 and 65535, got: %d", port),
+			)
+		}
+	}
+
+	if !data.Transport.IsNull() && !data.Transport.IsUnknown() {
+		switch data.Transport.ValueString() {
+		case "udp", "tcp", "tls":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("transport"),
+				"Invalid Syslog Transport",
+				fmt.Sprintf("The transport must be one of `udp`, `tcp` or `tls`, got: %s", data.Transport.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *SyslogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SyslogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.SyslogResponse, error) {
+		return r.client.ModifySyslog(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create syslog, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource syslog")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SyslogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SyslogResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.SyslogResponse, error) {
+		return r.client.ShowSyslog()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read syslog, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SyslogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SyslogResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, diags := r.parameters(ctx, data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.SyslogResponse, error) {
+		return r.client.ModifySyslog(parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update syslog, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SyslogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	operation := ClientBackoff(func() (*api.SyslogResponse, error) {
+		return r.client.ModifySyslog(api.SyslogParameters{
+			Emergency: []string{},
+			Critical:  []string{},
+			Error:     []string{},
+			Warning:   []string{},
+			Notice:    []string{},
+			Info:      []string{},
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset syslog, got error: %s", err))
+		return
+	}
+}
+
+func (r *SyslogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data SyslogResourceModel
+
+	operation := ClientBackoff(func() (*api.SyslogResponse, error) {
+		return r.client.ShowSyslog()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read syslog for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SyslogResource) parameters(ctx context.Context, data SyslogResourceModel) (api.SyslogParameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hosts := func(set types.Set) []string {
+		values, d := stringSetValues(ctx, set)
+		diags.Append(d...)
+
+		return values
+	}
+
+	return api.SyslogParameters{
+		Emergency: hosts(data.EmergencyHosts),
+		Critical:  hosts(data.CriticalHosts),
+		Error:     hosts(data.ErrorHosts),
+		Warning:   hosts(data.WarningHosts),
+		Notice:    hosts(data.NoticeHosts),
+		Info:      hosts(data.InfoHosts),
+		Port:      data.Port.ValueInt32Pointer(),
+		Transport: data.Transport.ValueString(),
+	}, diags
+}
+
+func (r *SyslogResource) fromResponse(ctx context.Context, data *SyslogResourceModel, response *api.SyslogResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	hosts := func(values []string) types.Set {
+		set, d := stringSetValue(ctx, values)
+		diags.Append(d...)
+
+		return set
+	}
+
+	data.Id = types.StringValue("syslog")
+	data.EmergencyHosts = hosts(response.Emergency)
+	data.CriticalHosts = hosts(response.Critical)
+	data.ErrorHosts = hosts(response.Error)
+	data.WarningHosts = hosts(response.Warning)
+	data.NoticeHosts = hosts(response.Notice)
+	data.InfoHosts = hosts(response.Info)
+	data.Port = types.Int32Value(response.Port)
+	data.Transport = types.StringValue(response.Transport)
+
+	return diags
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestSyslogResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testSyslogResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_syslog.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("syslog"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_syslog.test",
+						tfjsonpath.New("error_hosts"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("192.0.2.10"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_syslog.test",
+						tfjsonpath.New("transport"),
+						knownvalue.StringExact("udp"),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_syslog.test",
+				ImportState:       true,
+				ImportStateId:     "syslog",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testSyslogResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_syslog.test",
+						tfjsonpath.New("transport"),
+						knownvalue.StringExact("tcp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_syslog.test",
+						tfjsonpath.New("port"),
+						knownvalue.Int32Exact(1514),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testSyslogResourceConfig = `
+resource "loadmaster_syslog" "test" {
+  error_hosts = ["192.0.2.10"]
+  transport = "udp"
+}
+`
+
+const testSyslogResourceConfigUpdate = `
+resource "loadmaster_syslog" "test" {
+  error_hosts = ["192.0.2.10"]
+  info_hosts = ["192.0.2.11"]
+  port = 1514
+  transport = "tcp"
+}
+`
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &SystemSettingsResource{}
+var _ resource.ResourceWithImportState = &SystemSettingsResource{}
+var _ resource.ResourceWithIdentity = &SystemSettingsResource{}
+
+func NewSystemSettingsResource() resource.Resource {
+	return &SystemSettingsResource{}
+}
+
+type SystemSettingsResource struct {
+	client *api.Client
+}
+
+type SystemSettingsResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Hostname            types.String `tfsdk:"hostname"`
+	DnsServers          types.List   `tfsdk:"dns_servers"`
+	SearchDomains       types.List   `tfsdk:"search_domains"`
+	NtpServers          types.List   `tfsdk:"ntp_servers"`
+	NtpKeyId            types.Int32  `tfsdk:"ntp_key_id"`
+	NtpKeyType          types.String `tfsdk:"ntp_key_type"`
+	NtpKeySecret        types.String `tfsdk:"ntp_key_secret"`
+	NtpKeySecretVersion types.Int32  `tfsdk:"ntp_key_secret_version"`
+	Timezone            types.String `tfsdk:"timezone"`
+}
+
+func (r *SystemSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_settings"
+}
+
+func (r *SystemSettingsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the system settings, always `system_settings`.")
+}
+
+func (r *SystemSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the hostname, DNS, NTP and time zone settings of the LoadMaster.\n\n" +
+			"This resource is a singleton, only one instance should exist per LoadMaster. Only the attributes which are set are managed, " +
+			"all other settings are left untouched and only read. Destroying the resource only removes it from the state, the settings on the LoadMaster are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, always `system_settings`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the LoadMaster.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_servers": schema.ListAttribute{
+				MarkdownDescription: "The addresses of the DNS servers, in the order they are queried.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"search_domains": schema.ListAttribute{
+				MarkdownDescription: "The domains appended to unqualified names.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ntp_servers": schema.ListAttribute{
+				MarkdownDescription: "The hostnames or addresses of the NTP servers.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ntp_key_id": schema.Int32Attribute{
+				MarkdownDescription: "The id of the key used to authenticate the NTP servers.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ntp_key_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the key used to authenticate the NTP servers, either `SHA-1` or `MD5`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ntp_key_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret of the key used to authenticate the NTP servers. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"ntp_key_secret_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `ntp_key_secret` to the LoadMaster again.",
+				Optional:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The time zone of the LoadMaster, e.g. `Europe/Zurich`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SystemSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemSettingsResourceModel
+	var config SystemSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "creating a resource")
+
+	resp.Diagnostics.Append(r.apply(ctx, data, SystemSettingsResourceModel{}, config.NtpKeySecret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	tflog.Trace(ctx, "created a resource system settings")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SystemSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *SystemSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemSettingsResourceModel
+	var config SystemSettingsResourceModel
+	var state SystemSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is only sent again when its version changes.
+	secret := types.StringNull()
+	if !data.NtpKeySecretVersion.Equal(state.NtpKeySecretVersion) {
+		secret = config.NtpKeySecret
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, data, state, secret)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "removed system settings from state, the settings on the LoadMaster are kept")
+}
+
+// ImportState ignores the given ID, the resource is a singleton. The same
+// settings as in Read are imported.
+func (r *SystemSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data SystemSettingsResourceModel
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+// apply sends every attribute which is set in the plan and differs from the
+// state. Attributes which are not set are never sent, the NTP key secret is
+// sent if it is not null.
+func (r *SystemSettingsResource) apply(ctx context.Context, plan SystemSettingsResourceModel, state SystemSettingsResourceModel, ntpKeySecret types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setString := func(param string, planned types.String, current types.String) {
+		if diags.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+			return
+		}
+
+		diags.Append(r.set(ctx, param, planned.ValueString())...)
+	}
+
+	setList := func(param string, planned types.List, current types.List) {
+		if diags.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+			return
+		}
+
+		var values []string
+		diags.Append(planned.ElementsAs(ctx, &values, false)...)
+
+		if !diags.HasError() {
+			diags.Append(r.set(ctx, param, strings.Join(values, " "))...)
+		}
+	}
+
+	ntpKeyId := types.StringNull()
+	if !plan.NtpKeyId.IsNull() && !plan.NtpKeyId.IsUnknown() {
+		ntpKeyId = types.StringValue(strconv.Itoa(int(plan.NtpKeyId.ValueInt32())))
+	}
+
+	currentNtpKeyId := types.StringNull()
+	if !state.NtpKeyId.IsNull() {
+		currentNtpKeyId = types.StringValue(strconv.Itoa(int(state.NtpKeyId.ValueInt32())))
+	}
+
+	setString("hostname", plan.Hostname, state.Hostname)
+	setList("namserver", plan.DnsServers, state.DnsServers)
+	setList("searchlist", plan.SearchDomains, state.SearchDomains)
+	setString("ntpkeytype", plan.NtpKeyType, state.NtpKeyType)
+	setString("ntpkeyid", ntpKeyId, currentNtpKeyId)
+	setString("ntpkeysecret", ntpKeySecret, types.StringNull())
+	setList("ntphost", plan.NtpServers, state.NtpServers)
+	setString("timezone", plan.Timezone, state.Timezone)
+
+	return diags
+}
+
+// refresh reads all settings, the ones which are not configured are computed.
+// The NTP key secret can not be read and is never stored.
+func (r *SystemSettingsResource) refresh(ctx context.Context, data *SystemSettingsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	getString := func(param string, value *types.String) {
+		if diags.HasError() {
+			return
+		}
+
+		parameter, d := r.get(ctx, param)
+		diags.Append(d...)
+		*value = types.StringValue(parameter)
+	}
+
+	getList := func(param string, value *types.List) {
+		if diags.HasError() {
+			return
+		}
+
+		parameter, d := r.get(ctx, param)
+		diags.Append(d...)
+
+		list, d := types.ListValueFrom(ctx, types.StringType, strings.Fields(parameter))
+		diags.Append(d...)
+		*value = list
+	}
+
+	var ntpKeyId types.String
+
+	getString("hostname", &data.Hostname)
+	getList("namserver", &data.DnsServers)
+	getList("searchlist", &data.SearchDomains)
+	getList("ntphost", &data.NtpServers)
+	getString("ntpkeytype", &data.NtpKeyType)
+	getString("ntpkeyid", &ntpKeyId)
+	getString("timezone", &data.Timezone)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	data.NtpKeyId = types.Int32Null()
+	if ntpKeyId.ValueString() != "" {
+		id, err := strconv.Atoi(ntpKeyId.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to parse ntp key id %s, got error: %s", ntpKeyId.ValueString(), err))
+			return diags
+		}
+
+		data.NtpKeyId = types.Int32Value(int32(id))
+	}
+
+	data.Id = types.StringValue("system_settings")
+	data.NtpKeySecret = types.StringNull()
+
+	return diags
+}
+
+func (r *SystemSettingsResource) get(ctx context.Context, param string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	operation := ClientBackoff(func() (*api.ParameterResponse, error) {
+		return r.client.GetParameter(param)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read parameter %s, got error: %s", param, err))
+		return "", diags
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	return response.Value, diags
+}
+
+func (r *SystemSettingsResource) set(ctx context.Context, param string, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.SetParameter(param, value)
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set parameter %s, got error: %s", param, err))
+	}
+
+	return diags
+}
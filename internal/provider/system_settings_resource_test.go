@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestSystemSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testSystemSettingsResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_system_settings.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("system_settings"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_system_settings.test",
+						tfjsonpath.New("dns_servers"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("192.0.2.53"),
+							knownvalue.StringExact("198.51.100.53"),
+						}),
+					),
+					// Settings which are not configured are read from the
+					// LoadMaster.
+					statecheck.ExpectKnownValue(
+						"loadmaster_system_settings.test",
+						tfjsonpath.New("hostname"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_system_settings.test",
+				ImportState:       true,
+				ImportStateId:     "system_settings",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testSystemSettingsResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_system_settings.test",
+						tfjsonpath.New("timezone"),
+						knownvalue.StringExact("UTC"),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testSystemSettingsResourceConfig = `
+resource "loadmaster_system_settings" "test" {
+  dns_servers = ["192.0.2.53", "198.51.100.53"]
+  timezone = "Europe/Zurich"
+}
+`
+
+const testSystemSettingsResourceConfigUpdate = `
+resource "loadmaster_system_settings" "test" {
+  dns_servers = ["192.0.2.53", "198.51.100.53"]
+  timezone = "UTC"
+}
+`
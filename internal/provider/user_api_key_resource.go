@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &UserApiKeyResource{}
+var _ resource.ResourceWithImportState = &UserApiKeyResource{}
+var _ resource.ResourceWithIdentity = &UserApiKeyResource{}
+
+func NewUserApiKeyResource() resource.Resource {
+	return &UserApiKeyResource{}
+}
+
+type UserApiKeyResource struct {
+	client *api.Client
+}
+
+type UserApiKeyResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Username types.String `tfsdk:"username"`
+	Key      types.String `tfsdk:"key"`
+}
+
+type UserApiKeyIdentityModel struct {
+	Username    types.String `tfsdk:"username"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+}
+
+func (r *UserApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_api_key"
+}
+
+func (r *UserApiKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"username": identityschema.StringAttribute{
+				Description:       "The name of the user.",
+				RequiredForImport: true,
+			},
+			"fingerprint": identityschema.StringAttribute{
+				Description:       "The fingerprint of the API key, as in the `id` attribute.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *UserApiKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates an API key for a local user of the LoadMaster.\n\nThe key is generated by the LoadMaster and stored in the state. Replace the resource to rotate the key.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the API key, in the form `username/fingerprint`. The fingerprint is derived from the key and does not reveal it.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the user owning the API key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The generated API key.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserApiKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "username", data.Username)
+	tflog.Debug(ctx, "creating a resource")
+
+	operation := ClientBackoff(func() (*api.UserApiKeyResponse, error) {
+		return r.client.GenerateUserApiKey(data.Username.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user api key, got error: %s", err))
+		return
+	}
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Key = types.StringValue(response.Key)
+	data.Id = types.StringValue(data.Username.ValueString() + "/" + apiKeyFingerprint(response.Key))
+
+	tflog.Trace(ctx, "created a resource user api key")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserApiKeyIdentityModel{Username: data.Username, Fingerprint: types.StringValue(apiKeyFingerprint(data.Key.ValueString()))})...)
+}
+
+func (r *UserApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserApiKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListUserApiKeysResponse, error) {
+		return r.client.ListUserApiKeys(data.Username.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isUserNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user api key, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Received valid response from API")
+
+	if !slices.Contains(response.Keys, data.Key.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserApiKeyIdentityModel{Username: data.Username, Fingerprint: types.StringValue(apiKeyFingerprint(data.Key.ValueString()))})...)
+}
+
+func (r *UserApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserApiKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserApiKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteUserApiKey(data.Username.ValueString(), data.Key.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isUserNotFoundError(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user api key, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data UserApiKeyResourceModel
+
+	// The string ID contains the key itself, the identity only its
+	// fingerprint to keep the key out of the plan output.
+	var username, key, fingerprint string
+	if identity, ok := importIdentity[UserApiKeyIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		username = identity.Username.ValueString()
+		fingerprint = identity.Fingerprint.ValueString()
+	} else {
+		id_list := strings.SplitN(req.ID, "/", 2)
+		if len(id_list) != 2 || id_list[0] == "" || id_list[1] == "" {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected import ID in the form `username/key`, got: %s", req.ID))
+			return
+		}
+		username, key = id_list[0], id_list[1]
+	}
+
+	operation := ClientBackoff(func() (*api.ListUserApiKeysResponse, error) {
+		return r.client.ListUserApiKeys(username)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user api key for import, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Received valid response from API")
+
+	if key == "" {
+		for _, k := range response.Keys {
+			if apiKeyFingerprint(k) == fingerprint {
+				key = k
+			}
+		}
+	}
+
+	if key == "" || !slices.Contains(response.Keys, key) {
+		resp.Diagnostics.AddError("Unknown API Key", fmt.Sprintf("The user %s has no such API key.", username))
+		return
+	}
+
+	data.Username = types.StringValue(username)
+	data.Key = types.StringValue(key)
+	data.Id = types.StringValue(username + "/" + apiKeyFingerprint(key))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, UserApiKeyIdentityModel{Username: data.Username, Fingerprint: types.StringValue(apiKeyFingerprint(data.Key.ValueString()))})...)
+}
+
+// apiKeyFingerprint returns a short, stable identifier for an API key which
+// can be shown in plans without revealing the key itself.
+func apiKeyFingerprint(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:6])
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestUserApiKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testUserApiKeyResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_user_api_key.test",
+						tfjsonpath.New("username"),
+						knownvalue.StringExact("tfautomation"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_user_api_key.test",
+						tfjsonpath.New("key"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestApiKeyFingerprint(t *testing.T) {
+	if apiKeyFingerprint("key-a") == apiKeyFingerprint("key-b") {
+		t.Errorf("expected different fingerprints for different keys")
+	}
+
+	if got := apiKeyFingerprint("key-a"); got != apiKeyFingerprint("key-a") || len(got) != 12 {
+		t.Errorf("expected a stable fingerprint of 12 characters, got %q", got)
+	}
+}
+
+const testUserApiKeyResourceConfig = `
+resource "loadmaster_user" "test" {
+  username = "tfautomation"
+  password = "Automation-Passw0rd"
+  virtual_services = true
+}
+
+resource "loadmaster_user_api_key" "test" {
+  username = loadmaster_user.test.username
+}
+`
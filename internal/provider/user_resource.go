@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithIdentity = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+type UserResource struct {
+	client *api.Client
+}
+
+type UserResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int32  `tfsdk:"password_version"`
+	CertificateOnly types.Bool   `tfsdk:"certificate_only"`
+	RealServers     types.Bool   `tfsdk:"real_servers"`
+	VirtualServices types.Bool   `tfsdk:"virtual_services"`
+	Rules           types.Bool   `tfsdk:"rules"`
+	Backups         types.Bool   `tfsdk:"backups"`
+	Certificates    types.Bool   `tfsdk:"certificates"`
+	UserAdmin       types.Bool   `tfsdk:"user_admin"`
+}
+
+// userPermissions maps the permission attributes to the permission names
+// used by the LoadMaster.
+var userPermissions = []struct {
+	permission string
+	value      func(*UserResourceModel) *types.Bool
+}{
+	{"real", func(m *UserResourceModel) *types.Bool { return &m.RealServers }},
+	{"vs", func(m *UserResourceModel) *types.Bool { return &m.VirtualServices }},
+	{"rules", func(m *UserResourceModel) *types.Bool { return &m.Rules }},
+	{"backup", func(m *UserResourceModel) *types.Bool { return &m.Backups }},
+	{"certs", func(m *UserResourceModel) *types.Bool { return &m.Certificates }},
+	{"users", func(m *UserResourceModel) *types.Bool { return &m.UserAdmin }},
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("username", "The name of the user.")
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	permission := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			MarkdownDescription: description + " Defaults to `false`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a local administrator user of the LoadMaster and its permissions.\n\nThe default administrator `bal` cannot be managed with this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user, same as `username`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The name of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password of the user. Required unless `certificate_only` is set. This value is write-only, it is never stored in the state.",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int32Attribute{
+				MarkdownDescription: "Change this value to send the `password` to the LoadMaster again.",
+				Optional:            true,
+			},
+			"certificate_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has no local password and can only log in with a client certificate. Changing this value recreates the user. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"real_servers":     permission("Whether the user may modify real servers."),
+			"virtual_services": permission("Whether the user may modify virtual services."),
+			"rules":            permission("Whether the user may modify rules."),
+			"backups":          permission("Whether the user may create and restore backups."),
+			"certificates":     permission("Whether the user may manage certificates."),
+			"user_admin":       permission("Whether the user may manage other users."),
+		},
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Username.ValueString() == "bal" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Invalid Username",
+			"The default administrator `bal` cannot be managed with this resource.",
+		)
+	}
+
+	if data.CertificateOnly.ValueBool() && !data.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Conflicting Password",
+			"A password cannot be set for a user with `certificate_only` enabled.",
+		)
+	}
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+	var config UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.CertificateOnly.ValueBool() && config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Password",
+			"A password is required for a user without `certificate_only`.",
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "username", data.Username)
+	tflog.Debug(ctx, "creating a resource")
+
+	parameters := r.parameters(data)
+	parameters.Password = config.Password.ValueStringPointer()
+	parameters.NoPassword = data.CertificateOnly.ValueBoolPointer()
+
+	operation := ClientBackoff(func() (*api.UserResponse, error) {
+		return r.client.AddUser(data.Username.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	tflog.Trace(ctx, "created a resource user")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("username"), data.Username)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.UserResponse, error) {
+		return r.client.ShowUser(data.Username.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if isUserNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("username"), data.Username)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserResourceModel
+	var config UserResourceModel
+	var state UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters := r.parameters(data)
+
+	// The password is only sent again when its version changes.
+	if !data.PasswordVersion.Equal(state.PasswordVersion) {
+		parameters.Password = config.Password.ValueStringPointer()
+	}
+
+	operation := ClientBackoff(func() (*api.UserResponse, error) {
+		return r.client.ModifyUser(data.Username.ValueString(), parameters)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.LoadMasterResponse, error) {
+		return r.client.DeleteUser(data.Username.ValueString())
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data UserResourceModel
+
+	username := importAttribute(ctx, req, "username", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.UserResponse, error) {
+		return r.client.ShowUser(username)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.Username = types.StringValue(username)
+	r.fromResponse(&data, response)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("username"), data.Username)...)
+}
+
+func (r *UserResource) parameters(data UserResourceModel) api.UserParameters {
+	permissions := []string{}
+	for _, p := range userPermissions {
+		if p.value(&data).ValueBool() {
+			permissions = append(permissions, p.permission)
+		}
+	}
+
+	return api.UserParameters{
+		Permissions: permissions,
+	}
+}
+
+func (r *UserResource) fromResponse(data *UserResourceModel, response *api.UserResponse) {
+	data.Id = types.StringValue(data.Username.ValueString())
+	data.Password = types.StringNull()
+	data.CertificateOnly = types.BoolValue(response.NoPassword)
+
+	for _, p := range userPermissions {
+		*p.value(data) = types.BoolValue(slices.Contains(response.Permissions, p.permission))
+	}
+}
+
+// isUserNotFoundError reports whether the LoadMaster rejected a request
+// because the referenced user does not exist.
+func isUserNotFoundError(err error) bool {
+	serr, ok := err.(*api.LoadMasterError)
+	if !ok {
+		return false
+	}
+
+	return serr.Message == "Unknown user"
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testUserResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("tfoperator"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("virtual_services"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("user_admin"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("password"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			{
+				ResourceName:            "loadmaster_user.test",
+				ImportState:             true,
+				ImportStateId:           "tfoperator",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"},
+			},
+			{
+				Config: testUserResourceConfigUpdate,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("virtual_services"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_user.test",
+						tfjsonpath.New("backups"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testUserResourceConfig = `
+resource "loadmaster_user" "test" {
+  username = "tfoperator"
+  password = "Operator-Passw0rd"
+  password_version = 1
+  real_servers = true
+  virtual_services = true
+}
+`
+
+const testUserResourceConfigUpdate = `
+resource "loadmaster_user" "test" {
+  username = "tfoperator"
+  password = "Operator-Passw0rd-2"
+  password_version = 2
+  real_servers = true
+  backups = true
+}
+`
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// The values the LoadMaster accepts for the enumerations shared by several
+// resources and data sources.
+var (
+	virtualServiceProtocols = []string{"tcp", "udp"}
+	virtualServiceTypes     = []string{"gen", "http", "http2", "ts", "tls", "log"}
+	matchTypes              = []string{"regex", "prefix", "postfix"}
+	forwardModes            = []string{"nat", "route"}
+)
+
+// oneOf validates that a string is one of the values.
+func oneOf(values ...string) []validator.String {
+	return []validator.String{stringvalidator.OneOf(values...)}
+}
+
+// portNumber validates that a number is a port between 1 and 65535.
+func portNumber() []validator.Int32 {
+	return []validator.Int32{int32validator.Between(1, 65535)}
+}
+
+// flagNumber validates that a number is a content rule flag between 0 and 9,
+// where 0 stands for no flag.
+func flagNumber() []validator.Int32 {
+	return []validator.Int32{int32validator.Between(0, 9)}
+}
+
+// ipAddress validates that a string is an IPv4 or IPv6 address.
+func ipAddress() []validator.String {
+	return []validator.String{ipAddressValidator{}}
+}
+
+// port validates that a string is a port between 1 and 65535, or a range of
+// ports such as `8000-8080` if allowRange is set.
+func port(allowRange bool) []validator.String {
+	return []validator.String{portValidator{allowRange: allowRange}}
+}
+
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if net.ParseIP(req.ConfigValue.ValueString()) == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Address",
+			fmt.Sprintf("The address must be an IPv4 or IPv6 address, got: %s", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+type portValidator struct {
+	allowRange bool
+}
+
+func (v portValidator) Description(ctx context.Context) string {
+	if v.allowRange {
+		return "value must be a port between 1 and 65535 or a range of ports such as `8000-8080`"
+	}
+
+	return "value must be a port between 1 and 65535"
+}
+
+func (v portValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v portValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !validPort(req.ConfigValue.ValueString(), v.allowRange) {
+		message := "The port must be between 1 and 65535"
+		if v.allowRange {
+			message += " or a range of ports such as `8000-8080`"
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Port",
+			fmt.Sprintf("%s, got: %s", message, req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// validPort returns whether the value is a port, or a range of ports with the
+// first port not greater than the last if allowRange is set.
+func validPort(value string, allowRange bool) bool {
+	first, last, isRange := strings.Cut(value, "-")
+	if !isRange {
+		last = first
+	} else if !allowRange {
+		return false
+	}
+
+	from, err := strconv.ParseUint(first, 10, 16)
+	if err != nil || from == 0 {
+		return false
+	}
+
+	to, err := strconv.ParseUint(last, 10, 16)
+	if err != nil || to == 0 {
+		return false
+	}
+
+	return from <= to
+}
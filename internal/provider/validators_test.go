@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidPort(t *testing.T) {
+	for _, tc := range []struct {
+		value      string
+		allowRange bool
+		want       bool
+	}{
+		{"80", false, true},
+		{"65535", false, true},
+		{"0", false, false},
+		{"65536", false, false},
+		{"http", false, false},
+		{"", false, false},
+		{"8000-8080", false, false},
+		{"8000-8080", true, true},
+		{"8080-8080", true, true},
+		{"8080-8000", true, false},
+		{"0-80", true, false},
+		{"80-", true, false},
+		{"80", true, true},
+	} {
+		if got := validPort(tc.value, tc.allowRange); got != tc.want {
+			t.Errorf("validPort(%q, %t) = %t, want %t", tc.value, tc.allowRange, got, tc.want)
+		}
+	}
+}
+
+func TestIpAddressValidator(t *testing.T) {
+	for _, tc := range []struct {
+		value types.String
+		valid bool
+	}{
+		{types.StringValue("10.0.0.4"), true},
+		{types.StringValue("2001:db8::1"), true},
+		{types.StringValue("10.0.0.0/24"), false},
+		{types.StringValue("lb.example.com"), false},
+		{types.StringNull(), true},
+		{types.StringUnknown(), true},
+	} {
+		req := validator.StringRequest{Path: path.Root("address"), ConfigValue: tc.value}
+		resp := &validator.StringResponse{}
+
+		ipAddressValidator{}.ValidateString(t.Context(), req, resp)
+
+		if resp.Diagnostics.HasError() == tc.valid {
+			t.Errorf("%s: expected valid %t, got: %v", tc.value, tc.valid, resp.Diagnostics)
+		}
+	}
+}
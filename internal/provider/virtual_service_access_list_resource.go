@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &VirtualServiceAccessListResource{}
+var _ resource.ResourceWithImportState = &VirtualServiceAccessListResource{}
+var _ resource.ResourceWithValidateConfig = &VirtualServiceAccessListResource{}
+var _ resource.ResourceWithIdentity = &VirtualServiceAccessListResource{}
+
+func NewVirtualServiceAccessListResource() resource.Resource {
+	return &VirtualServiceAccessListResource{}
+}
+
+type VirtualServiceAccessListResource struct {
+	client *api.Client
+}
+
+type VirtualServiceAccessListResourceModel struct {
+	Id               types.String           `tfsdk:"id"`
+	VirtualServiceId types.String           `tfsdk:"virtual_service_id"`
+	Type             types.String           `tfsdk:"type"`
+	Entries          []AccessListEntryModel `tfsdk:"entries"`
+}
+
+type VirtualServiceAccessListIdentityModel struct {
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	Type             types.String `tfsdk:"type"`
+}
+
+func (r *VirtualServiceAccessListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_service_access_list"
+}
+
+func (r *VirtualServiceAccessListResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"virtual_service_id": identityschema.StringAttribute{
+				Description:       "Identifier of the virtual service.",
+				RequiredForImport: true,
+			},
+			"type": identityschema.StringAttribute{
+				Description:       "The type of the access list, either `allow` or `block`.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *VirtualServiceAccessListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the allow or block list of a `VirtualService`.\n\nThis resource is authoritative, entries of the list which are not configured are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the access list in the format `virtual_service_id/type`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_service_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the virtual service. This is also called `Index` in the LoadMaster API.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the access list, either `allow` or `block`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": accessListEntriesSchema(),
+		},
+	}
+}
+
+func (r *VirtualServiceAccessListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VirtualServiceAccessListResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VirtualServiceAccessListResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAccessList(data.Type, data.Entries)...)
+}
+
+func (r *VirtualServiceAccessListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualServiceAccessListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "virtual_service_id", data.VirtualServiceId)
+	ctx = tflog.SetField(ctx, "type", data.Type)
+	tflog.Debug(ctx, "creating a resource")
+
+	id := data.VirtualServiceId.ValueString()
+	list := accessListName(data.Type.ValueString())
+
+	current, err := r.read(ctx, id, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service access list, got error: %s", err))
+		return
+	}
+
+	err = r.apply(ctx, id, list, current, data.Entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create virtual service access list, got error: %s", err))
+		return
+	}
+
+	entries, err := r.read(ctx, id, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id + "/" + data.Type.ValueString())
+	data.Entries = entries
+
+	tflog.Trace(ctx, "created a resource virtual service access list")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceAccessListIdentityModel{VirtualServiceId: data.VirtualServiceId, Type: data.Type})...)
+}
+
+func (r *VirtualServiceAccessListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtualServiceAccessListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := r.read(ctx, data.VirtualServiceId.ValueString(), accessListName(data.Type.ValueString()))
+	if err != nil {
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Message == "Unknown VS" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(data.VirtualServiceId.ValueString() + "/" + data.Type.ValueString())
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceAccessListIdentityModel{VirtualServiceId: data.VirtualServiceId, Type: data.Type})...)
+}
+
+func (r *VirtualServiceAccessListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtualServiceAccessListResourceModel
+	var state VirtualServiceAccessListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.VirtualServiceId.ValueString()
+	list := accessListName(data.Type.ValueString())
+
+	err := r.apply(ctx, id, list, state.Entries, data.Entries)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update virtual service access list, got error: %s", err))
+		return
+	}
+
+	entries, err := r.read(ctx, id, list)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service access list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id + "/" + data.Type.ValueString())
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualServiceAccessListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtualServiceAccessListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, data.VirtualServiceId.ValueString(), accessListName(data.Type.ValueString()), data.Entries, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete virtual service access list, got error: %s", err))
+		return
+	}
+}
+
+func (r *VirtualServiceAccessListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data VirtualServiceAccessListResourceModel
+
+	id := req.ID
+	if identity, ok := importIdentity[VirtualServiceAccessListIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		id = identity.VirtualServiceId.ValueString() + "/" + identity.Type.ValueString()
+	}
+
+	id_list := strings.Split(id, "/")
+
+	if len(id_list) != 2 || (id_list[1] != "allow" && id_list[1] != "block") {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s, expected `virtual_service_id/allow` or `virtual_service_id/block`", id))
+		return
+	}
+
+	entries, err := r.read(ctx, id_list[0], accessListName(id_list[1]))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service access list for import, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(id)
+	data.VirtualServiceId = types.StringValue(id_list[0])
+	data.Type = types.StringValue(id_list[1])
+	data.Entries = entries
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceAccessListIdentityModel{VirtualServiceId: data.VirtualServiceId, Type: data.Type})...)
+}
+
+func (r *VirtualServiceAccessListResource) read(ctx context.Context, id string, list string) ([]AccessListEntryModel, error) {
+	operation := ClientBackoff(func() (*api.AccessControlListResponse, error) {
+		return r.client.ListVirtualServiceAccessControlList(id, list)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	return accessListEntriesFromResponse(response), nil
+}
+
+func (r *VirtualServiceAccessListResource) apply(ctx context.Context, id string, list string, current []AccessListEntryModel, desired []AccessListEntryModel) error {
+	return applyAccessListDiff(ctx, current, desired,
+		func(entry AccessListEntryModel) (*api.LoadMasterResponse, error) {
+			return r.client.DeleteVirtualServiceAccessControlListAddress(id, list, entry.Address.ValueString())
+		},
+		func(entry AccessListEntryModel) (*api.LoadMasterResponse, error) {
+			return r.client.AddVirtualServiceAccessControlListAddress(id, list, entry.Address.ValueString(), entry.Comment.ValueString())
+		},
+	)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVirtualServiceAccessListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testVirtualServiceAccessListResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_access_list.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_access_list.test",
+						tfjsonpath.New("entries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"address": knownvalue.StringExact("198.51.100.0/24"),
+								"comment": knownvalue.StringExact("office"),
+							}),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_virtual_service_access_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:          testVirtualServiceAccessListResourceConfig,
+				ResourceName:    "loadmaster_virtual_service_access_list.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+const testVirtualServiceAccessListResourceConfig = `
+resource "loadmaster_virtual_service" "test" {
+  address = "10.0.0.4"
+  port = "9090"
+  protocol = "tcp"
+}
+
+resource "loadmaster_virtual_service_access_list" "test" {
+  virtual_service_id = loadmaster_virtual_service.test.id
+  type = "allow"
+  entries = [
+    {
+      address = "198.51.100.0/24"
+      comment = "office"
+    },
+  ]
+}
+`
@@ -9,17 +9,21 @@ import (
 	"strconv"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/kreemer/loadmaster-go-client/api"
 )
 
 var (
-	_ datasource.DataSource              = &VirtualServiceDataSource{}
-	_ datasource.DataSourceWithConfigure = &VirtualServiceDataSource{}
+	_ datasource.DataSource                     = &VirtualServiceDataSource{}
+	_ datasource.DataSourceWithConfigure        = &VirtualServiceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &VirtualServiceDataSource{}
 )
 
 func NewVirtualServiceDataSource() datasource.DataSource {
@@ -47,31 +51,39 @@ func (d *VirtualServiceDataSource) Metadata(ctx context.Context, req datasource.
 func (d *VirtualServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "Use this data source to retrieve information about a virtual service.",
+		MarkdownDescription: "Use this data source to retrieve information about a virtual service. The virtual service is looked up by exactly one of `id`, `nickname` or `address`, `port` and `protocol`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The virtual service id. This is also called `Index` in the LoadMaster API.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 			},
 			"address": schema.StringAttribute{
 				MarkdownDescription: "The address of the virtual service. Should be an IP address of an interface attached to the LoadMaster.",
+				Optional:            true,
 				Computed:            true,
+				Validators:          ipAddress(),
 			},
 			"port": schema.StringAttribute{
-				MarkdownDescription: "The port of the virtual service.",
+				MarkdownDescription: "The port of the virtual service, or a range of ports such as `8000-8080`.",
+				Optional:            true,
 				Computed:            true,
+				Validators:          port(true),
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "The protocol of the virtual service, either `tcp` or `udp`.",
+				Optional:            true,
 				Computed:            true,
+				Validators:          oneOf(virtualServiceProtocols...),
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of the virtual service, either `gen`, `http`, `http2`, `ts`, `tls` or `log`.",
 				Computed:            true,
 			},
 			"nickname": schema.StringAttribute{
-				MarkdownDescription: "The nickname of the virtual service.",
+				MarkdownDescription: "The nickname of the virtual service. The lookup fails if several virtual services have the nickname.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"enabled": schema.BoolAttribute{
@@ -102,6 +114,21 @@ func (d *VirtualServiceDataSource) Configure(ctx context.Context, req datasource
 	d.client = client
 }
 
+func (d *VirtualServiceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("nickname"),
+			path.MatchRoot("address"),
+		),
+		datasourcevalidator.RequiredTogether(
+			path.MatchRoot("address"),
+			path.MatchRoot("port"),
+			path.MatchRoot("protocol"),
+		),
+	}
+}
+
 func (d *VirtualServiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data VirtualServiceDataSourceModel
 
@@ -112,6 +139,16 @@ func (d *VirtualServiceDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	id := data.Id.ValueString()
+	if data.Id.IsNull() {
+		var diags diag.Diagnostics
+		id, diags = d.lookup(ctx, data)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
 		return d.client.ShowVirtualService(id)
 	})
@@ -135,3 +172,23 @@ func (d *VirtualServiceDataSource) Read(ctx context.Context, req datasource.Read
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// lookup returns the index of the virtual service with the nickname or the
+// address, port and protocol of the configuration.
+func (d *VirtualServiceDataSource) lookup(ctx context.Context, data VirtualServiceDataSourceModel) (string, diag.Diagnostics) {
+	if !data.Nickname.IsNull() {
+		nickname := data.Nickname.ValueString()
+
+		return lookupVirtualService(ctx, d.client, "virtual service", fmt.Sprintf("the nickname %q", nickname), func(vs api.VirtualServiceResponse) bool {
+			return vs.MasterVSID == 0 && vs.NickName == nickname
+		})
+	}
+
+	key := virtualServiceKey{
+		Address:  data.Address.ValueString(),
+		Port:     data.Port.ValueString(),
+		Protocol: data.Protocol.ValueString(),
+	}
+
+	return resolveVirtualServiceKey(ctx, d.client, key)
+}
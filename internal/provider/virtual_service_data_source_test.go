@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -49,3 +50,68 @@ data "loadmaster_virtual_service" "test" {
 	id = loadmaster_virtual_service.example.id
 }
 `
+
+func TestVirtualServiceDataSourceLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testVirtualServiceDataSourceLookupConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_virtual_service.by_nickname",
+						tfjsonpath.New("port"),
+						knownvalue.StringExact("9093"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_virtual_service.by_address",
+						tfjsonpath.New("nickname"),
+						knownvalue.StringExact("shared-lookup"),
+					),
+				},
+			},
+			{
+				Config: `
+data "loadmaster_virtual_service" "test" {
+	id       = "1"
+	nickname = "shared-lookup"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: `
+data "loadmaster_virtual_service" "test" {
+	address = "10.0.0.4"
+	port    = "9093"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+const testVirtualServiceDataSourceLookupConfig = `
+resource "loadmaster_virtual_service" "example" {
+	address = "10.0.0.4"
+	port = "9093"
+	protocol = "tcp"
+	nickname = "shared-lookup"
+}
+
+data "loadmaster_virtual_service" "by_nickname" {
+	nickname = "shared-lookup"
+
+	depends_on = [loadmaster_virtual_service.example]
+}
+
+data "loadmaster_virtual_service" "by_address" {
+	address  = "10.0.0.4"
+	port     = "9093"
+	protocol = "tcp"
+
+	depends_on = [loadmaster_virtual_service.example]
+}
+`
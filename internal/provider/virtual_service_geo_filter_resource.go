@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ resource.Resource = &VirtualServiceGeoFilterResource{}
+var _ resource.ResourceWithImportState = &VirtualServiceGeoFilterResource{}
+var _ resource.ResourceWithValidateConfig = &VirtualServiceGeoFilterResource{}
+var _ resource.ResourceWithIdentity = &VirtualServiceGeoFilterResource{}
+
+func NewVirtualServiceGeoFilterResource() resource.Resource {
+	return &VirtualServiceGeoFilterResource{}
+}
+
+type VirtualServiceGeoFilterResource struct {
+	client *api.Client
+}
+
+type VirtualServiceGeoFilterResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	VirtualServiceId     types.String `tfsdk:"virtual_service_id"`
+	Mode                 types.String `tfsdk:"mode"`
+	Countries            types.Set    `tfsdk:"countries"`
+	IpReputationBlocking types.Bool   `tfsdk:"ip_reputation_blocking"`
+}
+
+func (r *VirtualServiceGeoFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_service_geo_filter"
+}
+
+func (r *VirtualServiceGeoFilterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = stringIdentitySchema("virtual_service_id", "Identifier of the virtual service.")
+}
+
+func (r *VirtualServiceGeoFilterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the geo filtering and IP reputation settings of a `VirtualService`.\n\nDestroying the resource clears the country list and disables IP reputation blocking of the virtual service.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the settings, same as `virtual_service_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_service_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the virtual service. This is also called `Index` in the LoadMaster API.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Whether the `countries` are blocked or allowed, either `block` or `allow`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"countries": schema.SetAttribute{
+				MarkdownDescription: "ISO 3166-1 alpha-2 codes of the countries to block or allow.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+			},
+			"ip_reputation_blocking": schema.BoolAttribute{
+				MarkdownDescription: "Block clients with a bad IP reputation.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *VirtualServiceGeoFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VirtualServiceGeoFilterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateGeoFilter(ctx, data.Mode, data.Countries)...)
+}
+
+func (r *VirtualServiceGeoFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "virtual_service_id", data.VirtualServiceId)
+	tflog.Debug(ctx, "creating a resource")
+
+	countries, diags := stringSetValues(ctx, data.Countries)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyVirtualServiceGeoFilter(data.VirtualServiceId.ValueString(), api.GeoFilterParameters{
+			Mode:                 data.Mode.ValueString(),
+			Countries:            countries,
+			IPReputationBlocking: data.IpReputationBlocking.ValueBoolPointer(),
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create virtual service geo filter, got error: %s", err))
+		return
+	}
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+
+	tflog.Trace(ctx, "created a resource virtual service geo filter")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("virtual_service_id"), data.VirtualServiceId)...)
+}
+
+func (r *VirtualServiceGeoFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ShowVirtualServiceGeoFilter(data.VirtualServiceId.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Message == "Unknown VS" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service geo filter, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("virtual_service_id"), data.VirtualServiceId)...)
+}
+
+func (r *VirtualServiceGeoFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	countries, diags := stringSetValues(ctx, data.Countries)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyVirtualServiceGeoFilter(data.VirtualServiceId.ValueString(), api.GeoFilterParameters{
+			Mode:                 data.Mode.ValueString(),
+			Countries:            countries,
+			IPReputationBlocking: data.IpReputationBlocking.ValueBoolPointer(),
+		})
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update virtual service geo filter, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VirtualServiceGeoFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ModifyVirtualServiceGeoFilter(data.VirtualServiceId.ValueString(), api.GeoFilterParameters{
+			Countries:            []string{},
+			IPReputationBlocking: bool2ptr(false),
+		})
+	})
+	_, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		if serr, ok := err.(*api.LoadMasterError); ok && serr.Message == "Unknown VS" {
+			return
+		}
+
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset virtual service geo filter, got error: %s", err))
+		return
+	}
+}
+
+func (r *VirtualServiceGeoFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data VirtualServiceGeoFilterResourceModel
+
+	virtualServiceId := importAttribute(ctx, req, "virtual_service_id", &resp.Diagnostics)
+
+	operation := ClientBackoff(func() (*api.GeoFilterResponse, error) {
+		return r.client.ShowVirtualServiceGeoFilter(virtualServiceId)
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read virtual service geo filter for import, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.VirtualServiceId = types.StringValue(virtualServiceId)
+	resp.Diagnostics.Append(r.fromResponse(ctx, &data, response)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.SetAttribute(ctx, path.Root("virtual_service_id"), data.VirtualServiceId)...)
+}
+
+func (r *VirtualServiceGeoFilterResource) fromResponse(ctx context.Context, data *VirtualServiceGeoFilterResourceModel, response *api.GeoFilterResponse) diag.Diagnostics {
+	countries, diags := stringSetValue(ctx, response.Countries)
+
+	data.Id = types.StringValue(data.VirtualServiceId.ValueString())
+	data.Mode = types.StringValue(response.Mode)
+	data.Countries = countries
+	data.IpReputationBlocking = types.BoolPointerValue(response.IPReputationBlocking)
+
+	return diags
+}
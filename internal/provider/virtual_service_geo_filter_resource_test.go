@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVirtualServiceGeoFilterResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testVirtualServiceGeoFilterResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_geo_filter.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_geo_filter.test",
+						tfjsonpath.New("mode"),
+						knownvalue.StringExact("allow"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_geo_filter.test",
+						tfjsonpath.New("countries"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("CH"),
+							knownvalue.StringExact("DE"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_geo_filter.test",
+						tfjsonpath.New("ip_reputation_blocking"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_virtual_service_geo_filter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testVirtualServiceGeoFilterResourceConfig = `
+resource "loadmaster_virtual_service" "test" {
+  address = "10.0.0.5"
+  port = "9090"
+  protocol = "tcp"
+}
+
+resource "loadmaster_virtual_service_geo_filter" "test" {
+  virtual_service_id = loadmaster_virtual_service.test.id
+  mode = "allow"
+  countries = ["CH", "DE"]
+  ip_reputation_blocking = true
+}
+`
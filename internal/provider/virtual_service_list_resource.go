@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var _ list.ListResource = &VirtualServiceListResource{}
+var _ list.ListResourceWithConfigure = &VirtualServiceListResource{}
+
+func NewVirtualServiceListResource() list.ListResource {
+	return &VirtualServiceListResource{}
+}
+
+type VirtualServiceListResource struct {
+	client *api.Client
+}
+
+type VirtualServiceListResourceModel struct {
+	Nickname types.String `tfsdk:"nickname"`
+	Address  types.String `tfsdk:"address"`
+	Port     types.String `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (r *VirtualServiceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_service"
+}
+
+func (r *VirtualServiceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the virtual services of the LoadMaster. Sub virtual services are listed by `loadmaster_sub_virtual_service`.",
+
+		Attributes: map[string]schema.Attribute{
+			"nickname": schema.StringAttribute{
+				MarkdownDescription: "A regular expression the nickname must match.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this address.",
+				Optional:            true,
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this port.",
+				Optional:            true,
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this protocol, either `tcp` or `udp`.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services of this type.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *VirtualServiceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VirtualServiceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data VirtualServiceListResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	nickname, d := listFilterRegexp(data.Nickname, "nickname")
+	diags.Append(d...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+		return r.client.ListVirtualServices()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list virtual services, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, vs := range response.VS {
+			if vs.MasterVSID != 0 || !nickname.MatchString(vs.NickName) ||
+				!listFilterMatches(data.Address, vs.Address) || !listFilterMatches(data.Port, vs.Port) ||
+				!listFilterMatches(data.Protocol, vs.Protocol) || !listFilterMatches(data.Type, vs.VSType) {
+				continue
+			}
+
+			if listLimitReached(req, count) {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = virtualServiceDisplayName(vs)
+
+			id := types.StringValue(strconv.Itoa(int(vs.Index)))
+			result.Diagnostics.Append(result.Identity.Set(ctx, IdIdentityModel{Id: id})...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, VirtualServiceResourceModel{
+					Id:       id,
+					Address:  types.StringValue(vs.Address),
+					Port:     types.StringValue(vs.Port),
+					Protocol: types.StringValue(vs.Protocol),
+					Type:     types.StringValue(vs.VSType),
+					Nickname: types.StringValue(vs.NickName),
+					Enabled:  types.BoolPointerValue(vs.Enable),
+
+					DeletionProtection: types.BoolValue(false),
+				})...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// virtualServiceDisplayName returns the nickname of the virtual service, or
+// its address, port and protocol if it has none.
+func virtualServiceDisplayName(vs api.VirtualServiceResponse) string {
+	if vs.NickName != "" {
+		return vs.NickName
+	}
+
+	return fmt.Sprintf("%s:%s/%s", vs.Address, vs.Port, vs.Protocol)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestVirtualServiceListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "loadmaster_virtual_service" "test" {
+  address  = "10.0.0.4"
+  port     = "9091"
+  protocol = "tcp"
+  nickname = "list-test"
+}
+`,
+			},
+			{
+				Query: true,
+				Config: `
+provider "loadmaster" {}
+
+list "loadmaster_virtual_service" "test" {
+  provider = loadmaster
+
+  config {
+    nickname = "^list-test$"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("loadmaster_virtual_service.test", 1),
+					querycheck.ExpectIdentity("loadmaster_virtual_service.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+				},
+			},
+		},
+	})
+}
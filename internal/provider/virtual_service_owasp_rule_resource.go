@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +23,8 @@ import (
 
 var _ resource.Resource = &VirtualServiceOwaspRuleResource{}
 var _ resource.ResourceWithImportState = &VirtualServiceOwaspRuleResource{}
+var _ resource.ResourceWithModifyPlan = &VirtualServiceOwaspRuleResource{}
+var _ resource.ResourceWithIdentity = &VirtualServiceOwaspRuleResource{}
 
 func NewVirtualServiceOwaspRuleResource() resource.Resource {
 	return &VirtualServiceOwaspRuleResource{}
@@ -36,17 +40,37 @@ type VirtualServiceOwaspRuleModel struct {
 	RunFirst         types.Bool   `tfsdk:"run_first"`
 }
 
+type VirtualServiceOwaspRuleIdentityModel struct {
+	VirtualServiceId types.String `tfsdk:"virtual_service_id"`
+	Rule             types.String `tfsdk:"rule"`
+}
+
 func (r *VirtualServiceOwaspRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_virtual_service_owasp_rule"
 }
 
+func (r *VirtualServiceOwaspRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"virtual_service_id": identityschema.StringAttribute{
+				Description:       "Identifier of the virtual service.",
+				RequiredForImport: true,
+			},
+			"rule": identityschema.StringAttribute{
+				Description:       "The name of the rule.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *VirtualServiceOwaspRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages connection between a `VirtualService` and an `OwapsCustomRule`.",
 
 		Attributes: map[string]schema.Attribute{
 			"virtual_service_id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the virtual service.",
+				MarkdownDescription: "Identifier of the virtual service. The virtual service must be of type `http` or `http2` or use SSL offloading.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -90,6 +114,25 @@ func (r *VirtualServiceOwaspRuleResource) Configure(ctx context.Context, req res
 	r.client = client
 }
 
+func (r *VirtualServiceOwaspRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only new resources are checked, existing ones were accepted by the LoadMaster.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(requireLicensedFeature(ctx, r.client, "waf", path.Root("rule"))...)
+
+	var virtualServiceId types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("virtual_service_id"), &virtualServiceId)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(warnLayer7VirtualService(ctx, r.client, virtualServiceId, "WAF", path.Root("virtual_service_id"))...)
+}
+
 func (r *VirtualServiceOwaspRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VirtualServiceOwaspRuleModel
 
@@ -99,6 +142,12 @@ func (r *VirtualServiceOwaspRuleResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	resp.Diagnostics.Append(requireLayer7VirtualService(ctx, r.client, data.VirtualServiceId, "WAF", path.Root("virtual_service_id"))...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "creating a resource")
 
 	operation := func() (*api.LoadMasterResponse, error) {
@@ -118,6 +167,7 @@ func (r *VirtualServiceOwaspRuleResource) Create(ctx context.Context, req resour
 	tflog.Trace(ctx, "created a resource owasp custom rule")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceOwaspRuleIdentityModel{VirtualServiceId: data.VirtualServiceId, Rule: data.Rule})...)
 }
 
 func (r *VirtualServiceOwaspRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -148,6 +198,7 @@ func (r *VirtualServiceOwaspRuleResource) Read(ctx context.Context, req resource
 	data.RunFirst = types.BoolValue(response.Rule.RunFirst == "yes")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceOwaspRuleIdentityModel{VirtualServiceId: data.VirtualServiceId, Rule: data.Rule})...)
 }
 
 func (r *VirtualServiceOwaspRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -179,10 +230,15 @@ func (r *VirtualServiceOwaspRuleResource) Delete(ctx context.Context, req resour
 func (r *VirtualServiceOwaspRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data VirtualServiceOwaspRuleModel
 
-	id_list := strings.Split(req.ID, "/")
+	id := req.ID
+	if identity, ok := importIdentity[VirtualServiceOwaspRuleIdentityModel](ctx, req, &resp.Diagnostics); ok {
+		id = identity.VirtualServiceId.ValueString() + "/" + identity.Rule.ValueString()
+	}
+
+	id_list := strings.Split(id, "/")
 
 	if len(id_list) != 2 {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", req.ID))
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", id))
 		return
 	}
 
@@ -206,4 +262,5 @@ func (r *VirtualServiceOwaspRuleResource) ImportState(ctx context.Context, req r
 	data.RunFirst = types.BoolValue(response.Rule.RunFirst == "yes")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, VirtualServiceOwaspRuleIdentityModel{VirtualServiceId: data.VirtualServiceId, Rule: data.Rule})...)
 }
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -70,6 +71,61 @@ func TestVirtualServiceOwaspRuleResource(t *testing.T) {
 	})
 }
 
+func TestVirtualServiceOwaspRuleResourceType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testVirtualServiceOwaspRuleResourceType("gen", false),
+			},
+			{
+				Config:      testVirtualServiceOwaspRuleResourceType("gen", true),
+				ExpectError: regexp.MustCompile("Unsupported Virtual Service Type"),
+			},
+			// The type is changed in the same apply as the rule is attached.
+			{
+				Config: testVirtualServiceOwaspRuleResourceType("http", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service_owasp_rule.gen_rule",
+						tfjsonpath.New("rule"),
+						knownvalue.StringExact("test_rule_gen"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testVirtualServiceOwaspRuleResourceType(vsType string, attach bool) string {
+	config := fmt.Sprintf(`
+resource "loadmaster_owasp_custom_rule" "gen_rule" {
+  filename = "test_rule_gen"
+  data = <<EOT
+SecRule REQUEST_METHOD "!@pm GET HEAD POST" "id:12100,phase:1,deny,status:405,log"
+EOT
+}
+
+resource "loadmaster_virtual_service" "gen" {
+  address = "10.0.0.4"
+  port = "9094"
+  protocol = "tcp"
+  type = %q
+}
+`, vsType)
+	if attach {
+		config += `
+resource "loadmaster_virtual_service_owasp_rule" "gen_rule" {
+  virtual_service_id = loadmaster_virtual_service.gen.id
+  rule = loadmaster_owasp_custom_rule.gen_rule.filename
+}
+`
+	}
+
+	return config
+}
+
 func testVirtualServiceOwaspRuleResource() string {
 	return `
 resource "loadmaster_owasp_custom_rule" "test_rule" {
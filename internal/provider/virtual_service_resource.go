@@ -7,10 +7,14 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -20,6 +24,9 @@ import (
 
 var _ resource.Resource = &VirtualServiceResource{}
 var _ resource.ResourceWithImportState = &VirtualServiceResource{}
+var _ resource.ResourceWithModifyPlan = &VirtualServiceResource{}
+var _ resource.ResourceWithValidateConfig = &VirtualServiceResource{}
+var _ resource.ResourceWithIdentity = &VirtualServiceResource{}
 
 func NewVirtualServiceResource() resource.Resource {
 	return &VirtualServiceResource{}
@@ -30,19 +37,39 @@ type VirtualServiceResource struct {
 }
 
 type VirtualServiceResourceModel struct {
-	Id       types.String `tfsdk:"id"`
-	Address  types.String `tfsdk:"address"`
-	Port     types.String `tfsdk:"port"`
-	Protocol types.String `tfsdk:"protocol"`
-	Type     types.String `tfsdk:"type"`
-	Nickname types.String `tfsdk:"nickname"`
-	Enabled  types.Bool   `tfsdk:"enabled"`
+	Id       types.String            `tfsdk:"id"`
+	Address  types.String            `tfsdk:"address"`
+	Port     types.String            `tfsdk:"port"`
+	Protocol types.String            `tfsdk:"protocol"`
+	Type     types.String            `tfsdk:"type"`
+	Nickname types.String            `tfsdk:"nickname"`
+	Enabled  types.Bool              `tfsdk:"enabled"`
+	Esp      *VirtualServiceEspModel `tfsdk:"esp"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+type VirtualServiceEspModel struct {
+	Enabled                    types.Bool   `tfsdk:"enabled"`
+	InputAuthMode              types.String `tfsdk:"input_auth_mode"`
+	OutputAuthMode             types.String `tfsdk:"output_auth_mode"`
+	InputSsoDomain             types.String `tfsdk:"input_sso_domain"`
+	OutputSsoDomain            types.String `tfsdk:"output_sso_domain"`
+	AllowedHosts               types.Set    `tfsdk:"allowed_hosts"`
+	AllowedDirectories         types.Set    `tfsdk:"allowed_directories"`
+	PreAuthExcludedDirectories types.Set    `tfsdk:"pre_auth_excluded_directories"`
+	LogonFormTemplate          types.String `tfsdk:"logon_form_template"`
+	LogonFormMessage           types.String `tfsdk:"logon_form_message"`
 }
 
 func (r *VirtualServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_virtual_service"
 }
 
+func (r *VirtualServiceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Identifier of the virtual service.")
+}
+
 func (r *VirtualServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a virtual service.",
@@ -56,15 +83,17 @@ func (r *VirtualServiceResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"address": schema.StringAttribute{
-				MarkdownDescription: "The address of the virtual service. Should be an IP address of an interface attached to the LoadMaster.",
+				MarkdownDescription: "The address of the virtual service. Should be an IP address of an interface attached to the LoadMaster, a warning is shown during plan otherwise.",
 				Required:            true,
+				Validators:          ipAddress(),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"port": schema.StringAttribute{
-				MarkdownDescription: "The port of the virtual service.",
+				MarkdownDescription: "The port of the virtual service, or a range of ports such as `8000-8080`.",
 				Required:            true,
+				Validators:          port(true),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -72,14 +101,16 @@ func (r *VirtualServiceResource) Schema(ctx context.Context, req resource.Schema
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "The protocol of the virtual service, either `tcp` or `udp`.",
 				Required:            true,
+				Validators:          oneOf(virtualServiceProtocols...),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the virtual service, either `gen`, `http`, `http2`, `ts`, `tls` or `log`.",
+				MarkdownDescription: "The type of the virtual service, either `gen`, `http`, `http2`, `ts`, `tls` or `log`. Virtual services with protocol `udp` cannot be of type `http` or `http2`. Changing the type resets the layer 7 settings of the virtual service on the LoadMaster.",
 				Computed:            true,
 				Optional:            true,
+				Validators:          oneOf(virtualServiceTypes...),
 			},
 			"nickname": schema.StringAttribute{
 				MarkdownDescription: "The nickname of the virtual service.",
@@ -91,6 +122,68 @@ func (r *VirtualServiceResource) Schema(ctx context.Context, req resource.Schema
 				Computed:            true,
 				Optional:            true,
 			},
+			"deletion_protection": deletionProtectionSchema("virtual service"),
+		},
+		Blocks: map[string]schema.Block{
+			"esp": schema.SingleNestedBlock{
+				MarkdownDescription: "The Edge Security Pack settings of the virtual service. Only available for virtual services of type `http` or `http2`. Without this block the Edge Security Pack is disabled, removing the block disables it.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the Edge Security Pack is enabled. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"input_auth_mode": schema.StringAttribute{
+						MarkdownDescription: "How clients authenticate. One of `none` (delegate to the real server), `basic`, `form`, `client_certificate`, `ntlm` or `saml`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"output_auth_mode": schema.StringAttribute{
+						MarkdownDescription: "How the LoadMaster authenticates against the real servers. One of `none`, `basic`, `form`, `kcd` or `ntlm`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"input_sso_domain": schema.StringAttribute{
+						MarkdownDescription: "The name of the client side SSO domain.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"output_sso_domain": schema.StringAttribute{
+						MarkdownDescription: "The name of the server side SSO domain.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"allowed_hosts": schema.SetAttribute{
+						MarkdownDescription: "The host names clients may request.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+					"allowed_directories": schema.SetAttribute{
+						MarkdownDescription: "The directories clients may request, e.g. `/owa/*`.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+					"pre_auth_excluded_directories": schema.SetAttribute{
+						MarkdownDescription: "The directories which are accessible without authentication.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Computed:            true,
+					},
+					"logon_form_template": schema.StringAttribute{
+						MarkdownDescription: "The name of the image set used for the logon form.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"logon_form_message": schema.StringAttribute{
+						MarkdownDescription: "The message shown on the logon form.",
+						Optional:            true,
+						Computed:            true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -113,6 +206,75 @@ func (r *VirtualServiceResource) Configure(ctx context.Context, req resource.Con
 	r.client = client
 }
 
+func (r *VirtualServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data VirtualServiceResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVirtualServiceProtocol(data.Protocol, data.Type)...)
+
+	if data.Esp == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVirtualServiceEsp(data.Type, data.Esp)...)
+}
+
+func (r *VirtualServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "virtual service")
+
+	if req.Plan.Raw.IsNull() || r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state VirtualServiceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(warnVirtualServiceTypeChange(state.Type, plan.Type)...)
+
+	if plan.Esp != nil && plan.Esp.Enabled.ValueBool() && (state.Esp == nil || !state.Esp.Enabled.ValueBool()) {
+		resp.Diagnostics.Append(requireLicensedFeature(ctx, r.client, "esp", path.Root("esp"))...)
+	}
+
+	// An unknown address usually comes from an interface address created in
+	// the same apply, which the interfaces listed now cannot contain yet.
+	if plan.Address.IsUnknown() || plan.Address.Equal(state.Address) {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListInterfaceResponse, error) {
+		return r.client.ListInterfaces()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list interfaces, skipping the address check", map[string]any{"error": err.Error()})
+		return
+	}
+
+	if !interfaceContainsAddress(response.Interfaces, plan.Address.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("address"),
+			"Possible Address Mismatch",
+			fmt.Sprintf("The address %s does not belong to any interface of the LoadMaster yet. "+
+				"This is expected if a loadmaster_network_interface or loadmaster_interface_address resource adds it in the same apply, "+
+				"otherwise the virtual service will not receive traffic.", plan.Address.ValueString()),
+		)
+	}
+}
+
 func (r *VirtualServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VirtualServiceResourceModel
 
@@ -127,6 +289,13 @@ func (r *VirtualServiceResource) Create(ctx context.Context, req resource.Create
 	ctx = tflog.SetField(ctx, "protocol", data.Protocol)
 	tflog.Debug(ctx, "creating a resource")
 
+	esp, diags := r.espParameters(ctx, data.Esp)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
 		return r.client.AddVirtualService(data.Address.ValueString(), data.Port.ValueString(), data.Protocol.ValueString(), api.VirtualServiceParameters{
 			VirtualServiceParametersBasicProperties: &api.VirtualServiceParametersBasicProperties{
@@ -134,6 +303,7 @@ func (r *VirtualServiceResource) Create(ctx context.Context, req resource.Create
 				VSType:   data.Type.ValueString(),
 				Enable:   bool2ptr(data.Enabled.ValueBool()),
 			},
+			VirtualServiceParametersESP: esp,
 		})
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
@@ -152,10 +322,12 @@ func (r *VirtualServiceResource) Create(ctx context.Context, req resource.Create
 	data.Type = types.StringValue(response.VSType)
 	data.Nickname = types.StringValue(response.NickName)
 	data.Enabled = types.BoolValue(*response.Enable)
+	resp.Diagnostics.Append(r.espFromResponse(ctx, &data, response)...)
 
 	tflog.Trace(ctx, "created a resource virtual service")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *VirtualServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -190,14 +362,29 @@ func (r *VirtualServiceResource) Read(ctx context.Context, req resource.ReadRequ
 	data.Type = types.StringValue(response.VSType)
 	data.Nickname = types.StringValue(response.NickName)
 	data.Enabled = types.BoolValue(*response.Enable)
+	resp.Diagnostics.Append(r.espFromResponse(ctx, &data, response)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
 }
 
 func (r *VirtualServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data VirtualServiceResourceModel
+	var data, state VirtualServiceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	esp, diags := r.espParameters(ctx, data.Esp)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing the esp block disables the Edge Security Pack.
+	if data.Esp == nil && state.Esp != nil {
+		esp = &api.VirtualServiceParametersESP{EspEnabled: bool2ptr(false)}
+	}
 
 	id := data.Id.ValueString()
 	operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
@@ -207,6 +394,7 @@ func (r *VirtualServiceResource) Update(ctx context.Context, req resource.Update
 				VSType:   data.Type.ValueString(),
 				Enable:   bool2ptr(data.Enabled.ValueBool()),
 			},
+			VirtualServiceParametersESP: esp,
 		})
 	})
 	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
@@ -225,6 +413,7 @@ func (r *VirtualServiceResource) Update(ctx context.Context, req resource.Update
 	data.Type = types.StringValue(response.VSType)
 	data.Nickname = types.StringValue(response.NickName)
 	data.Enabled = types.BoolValue(*response.Enable)
+	resp.Diagnostics.Append(r.espFromResponse(ctx, &data, response)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -238,6 +427,11 @@ func (r *VirtualServiceResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionError("virtual service")...)
+		return
+	}
+
 	id := data.Id.ValueString()
 	operation := ClientBackoff(func() (*api.DeleteVirtualServiceResponse, error) {
 		return r.client.DeleteVirtualService(id)
@@ -252,7 +446,22 @@ func (r *VirtualServiceResource) Delete(ctx context.Context, req resource.Delete
 func (r *VirtualServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	var data VirtualServiceResourceModel
 
-	id := req.ID
+	id := importId(ctx, req, &resp.Diagnostics)
+
+	if isNaturalKey(id) {
+		key, err := parseVirtualServiceKey(id)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse ID: %s", err))
+			return
+		}
+
+		var diags diag.Diagnostics
+		id, diags = resolveVirtualServiceKey(ctx, r.client, key)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
 		return r.client.ShowVirtualService(id)
@@ -278,6 +487,137 @@ func (r *VirtualServiceResource) ImportState(ctx context.Context, req resource.I
 	data.Type = types.StringValue(response.VSType)
 	data.Nickname = types.StringValue(response.NickName)
 	data.Enabled = types.BoolValue(*response.Enable)
+	data.DeletionProtection = types.BoolValue(false)
+	resp.Diagnostics.Append(r.espFromResponse(ctx, &data, response)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, IdIdentityModel{Id: data.Id})...)
+}
+
+func (r *VirtualServiceResource) espParameters(ctx context.Context, esp *VirtualServiceEspModel) (*api.VirtualServiceParametersESP, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if esp == nil {
+		return nil, diags
+	}
+
+	// The LoadMaster expects space separated lists, nil keeps the current value.
+	list := func(set types.Set) *string {
+		values, d := stringSetValues(ctx, set)
+		diags.Append(d...)
+
+		if values == nil {
+			return nil
+		}
+
+		joined := strings.Join(values, " ")
+		return &joined
+	}
+
+	return &api.VirtualServiceParametersESP{
+		EspEnabled:          esp.Enabled.ValueBoolPointer(),
+		InputAuthMode:       esp.InputAuthMode.ValueString(),
+		OutputAuthMode:      esp.OutputAuthMode.ValueString(),
+		Domain:              esp.InputSsoDomain.ValueString(),
+		OutConf:             esp.OutputSsoDomain.ValueString(),
+		AllowedHosts:        list(esp.AllowedHosts),
+		AllowedDirectories:  list(esp.AllowedDirectories),
+		ExcludedDirectories: list(esp.PreAuthExcludedDirectories),
+		SingleSignOnDir:     esp.LogonFormTemplate.ValueString(),
+		SingleSignOnMessage: esp.LogonFormMessage.ValueString(),
+	}, diags
+}
+
+func (r *VirtualServiceResource) espFromResponse(ctx context.Context, data *VirtualServiceResourceModel, response *api.VirtualServiceResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Without the esp block the Edge Security Pack is expected to be disabled,
+	// so an enabled one is read into the block to show up as a change.
+	if data.Esp == nil {
+		if response.EspEnabled == nil || !*response.EspEnabled {
+			return diags
+		}
+
+		data.Esp = &VirtualServiceEspModel{}
+	}
+
+	list := func(value string) types.Set {
+		set, d := stringSetValue(ctx, strings.Fields(value))
+		diags.Append(d...)
+
+		return set
+	}
+
+	data.Esp.Enabled = types.BoolValue(response.EspEnabled != nil && *response.EspEnabled)
+	data.Esp.InputAuthMode = types.StringValue(response.InputAuthMode)
+	data.Esp.OutputAuthMode = types.StringValue(response.OutputAuthMode)
+	data.Esp.InputSsoDomain = types.StringValue(response.Domain)
+	data.Esp.OutputSsoDomain = types.StringValue(response.OutConf)
+	data.Esp.AllowedHosts = list(response.AllowedHosts)
+	data.Esp.AllowedDirectories = list(response.AllowedDirectories)
+	data.Esp.PreAuthExcludedDirectories = list(response.ExcludedDirectories)
+	data.Esp.LogonFormTemplate = types.StringValue(response.SingleSignOnDir)
+	data.Esp.LogonFormMessage = types.StringValue(response.SingleSignOnMessage)
+
+	return diags
+}
+
+// validateVirtualServiceEsp checks the ESP settings against the type of the
+// virtual service and the selected authentication modes.
+func validateVirtualServiceEsp(vsType types.String, esp *VirtualServiceEspModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !esp.Enabled.IsNull() && !esp.Enabled.IsUnknown() && !esp.Enabled.ValueBool() {
+		return diags
+	}
+
+	if !vsType.IsUnknown() && vsType.ValueString() != "http" && vsType.ValueString() != "http2" {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Invalid Virtual Service Type",
+			"The Edge Security Pack is only available for virtual services of type `http` or `http2`.",
+		)
+	}
+
+	if !esp.InputAuthMode.IsNull() && !esp.InputAuthMode.IsUnknown() {
+		switch mode := esp.InputAuthMode.ValueString(); mode {
+		case "none":
+		case "basic", "form", "client_certificate", "ntlm", "saml":
+			if esp.InputSsoDomain.IsNull() {
+				diags.AddAttributeError(
+					path.Root("esp").AtName("input_sso_domain"),
+					"Missing SSO Domain",
+					fmt.Sprintf("An input SSO domain is required for the input authentication mode %s.", mode),
+				)
+			}
+		default:
+			diags.AddAttributeError(
+				path.Root("esp").AtName("input_auth_mode"),
+				"Invalid Authentication Mode",
+				fmt.Sprintf("The input authentication mode must be one of `none`, `basic`, `form`, `client_certificate`, `ntlm` or `saml`, got: %s", mode),
+			)
+		}
+	}
+
+	if !esp.OutputAuthMode.IsNull() && !esp.OutputAuthMode.IsUnknown() {
+		switch mode := esp.OutputAuthMode.ValueString(); mode {
+		case "none":
+		case "basic", "form", "kcd", "ntlm":
+			if esp.OutputSsoDomain.IsNull() {
+				diags.AddAttributeError(
+					path.Root("esp").AtName("output_sso_domain"),
+					"Missing SSO Domain",
+					fmt.Sprintf("An output SSO domain is required for the output authentication mode %s.", mode),
+				)
+			}
+		default:
+			diags.AddAttributeError(
+				path.Root("esp").AtName("output_auth_mode"),
+				"Invalid Authentication Mode",
+				fmt.Sprintf("The output authentication mode must be one of `none`, `basic`, `form`, `kcd` or `ntlm`, got: %s", mode),
+			)
+		}
+	}
+
+	return diags
 }
@@ -5,8 +5,10 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -59,6 +61,12 @@ func TestVirtualServiceResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "loadmaster_virtual_service.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "10.0.0.4:9090:tcp",
+			},
 			{
 				Config: testVirtualServiceResourceConfig("blupp"),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -113,6 +121,164 @@ func TestVirtualServiceResource(t *testing.T) {
 	})
 }
 
+func TestVirtualServiceResourceEsp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testVirtualServiceResourceConfigEsp("/owa/*"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.esp",
+						tfjsonpath.New("esp").AtMapKey("enabled"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.esp",
+						tfjsonpath.New("esp").AtMapKey("input_sso_domain"),
+						knownvalue.StringExact("tfesp"),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.esp",
+						tfjsonpath.New("esp").AtMapKey("allowed_directories"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("/owa/*"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_virtual_service.esp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testVirtualServiceResourceConfigEsp("/ecp/*"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.esp",
+						tfjsonpath.New("esp").AtMapKey("allowed_directories"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("/ecp/*"),
+						}),
+					),
+				},
+			},
+			// Removing the block disables ESP
+			{
+				Config: testVirtualServiceResourceConfigEspRemoved(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.esp",
+						tfjsonpath.New("esp"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestVirtualServiceResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testVirtualServiceResourceConfigInvalid("10.0.0.4", "9090", "sctp"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config:      testVirtualServiceResourceConfigInvalid("10.0.0.4", "70000", "tcp"),
+				ExpectError: regexp.MustCompile("Invalid Port"),
+			},
+			{
+				Config:      testVirtualServiceResourceConfigInvalid("lb.example.com", "9090", "tcp"),
+				ExpectError: regexp.MustCompile("Invalid Address"),
+			},
+			{
+				Config: `
+resource "loadmaster_virtual_service" "invalid" {
+  address = "10.0.0.4"
+  port = "9090"
+  protocol = "udp"
+  type = "http"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Virtual Service Type"),
+			},
+		},
+	})
+}
+
+func TestVirtualServiceResourceDeletionProtection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testVirtualServiceResourceConfigProtected("9095", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.protected",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				Config:      testVirtualServiceResourceConfigProtected("9096", true),
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config:      testVirtualServiceResourceConfigProtected("9096", false),
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config:      testVirtualServiceResourceConfigProtected("9095", true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testVirtualServiceResourceConfigProtected("9095", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_virtual_service.protected",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidateVirtualServiceEsp(t *testing.T) {
+	cases := []struct {
+		vsType types.String
+		esp    VirtualServiceEspModel
+		errors int
+	}{
+		{types.StringValue("http"), VirtualServiceEspModel{InputAuthMode: types.StringValue("form"), InputSsoDomain: types.StringValue("corp")}, 0},
+		{types.StringValue("http"), VirtualServiceEspModel{InputAuthMode: types.StringValue("form")}, 1},
+		{types.StringValue("gen"), VirtualServiceEspModel{}, 1},
+		{types.StringNull(), VirtualServiceEspModel{}, 1},
+		{types.StringUnknown(), VirtualServiceEspModel{}, 0},
+		{types.StringValue("gen"), VirtualServiceEspModel{Enabled: types.BoolValue(false)}, 0},
+		{types.StringValue("http2"), VirtualServiceEspModel{OutputAuthMode: types.StringValue("kerberos")}, 1},
+		{types.StringValue("http2"), VirtualServiceEspModel{OutputAuthMode: types.StringValue("kcd"), OutputSsoDomain: types.StringValue("kcd")}, 0},
+	}
+
+	for i, c := range cases {
+		esp := c.esp
+		if diags := validateVirtualServiceEsp(c.vsType, &esp); diags.ErrorsCount() != c.errors {
+			t.Errorf("case %d: expected %d errors, got %d: %v", i, c.errors, diags.ErrorsCount(), diags)
+		}
+	}
+}
+
 func testVirtualServiceResourceConfig(nickname string) string {
 	return fmt.Sprintf(`
 resource "loadmaster_virtual_service" "test" {
@@ -137,3 +303,77 @@ resource "loadmaster_virtual_service" "test2" {
 }
 `
 }
+
+func testVirtualServiceResourceConfigEsp(directory string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_ldap_endpoint" "esp" {
+  name = "tfesp"
+  servers = ["192.0.2.30"]
+}
+
+resource "loadmaster_sso_domain" "esp" {
+  name = "tfesp"
+  auth_protocol = "ldap"
+  ldap_endpoint = loadmaster_ldap_endpoint.esp.name
+}
+
+resource "loadmaster_virtual_service" "esp" {
+  address = "10.0.0.4"
+  port = "9443"
+  protocol = "tcp"
+  type = "http"
+
+  esp {
+    input_auth_mode = "form"
+    input_sso_domain = loadmaster_sso_domain.esp.name
+    allowed_hosts = ["mail.example.com"]
+    allowed_directories = ["%s"]
+    pre_auth_excluded_directories = ["/public/*"]
+  }
+}
+`, directory)
+}
+
+func testVirtualServiceResourceConfigEspRemoved() string {
+	return `
+resource "loadmaster_ldap_endpoint" "esp" {
+  name = "tfesp"
+  servers = ["192.0.2.30"]
+}
+
+resource "loadmaster_sso_domain" "esp" {
+  name = "tfesp"
+  auth_protocol = "ldap"
+  ldap_endpoint = loadmaster_ldap_endpoint.esp.name
+}
+
+resource "loadmaster_virtual_service" "esp" {
+  address = "10.0.0.4"
+  port = "9443"
+  protocol = "tcp"
+  type = "http"
+}
+`
+}
+
+func testVirtualServiceResourceConfigInvalid(address string, port string, protocol string) string {
+	return fmt.Sprintf(`
+resource "loadmaster_virtual_service" "invalid" {
+  address = %[1]q
+  port = %[2]q
+  protocol = %[3]q
+}
+`, address, port, protocol)
+}
+
+func testVirtualServiceResourceConfigProtected(port string, protected bool) string {
+	return fmt.Sprintf(`
+resource "loadmaster_virtual_service" "protected" {
+  address = "10.0.0.4"
+  port = %[1]q
+  protocol = "tcp"
+
+  deletion_protection = %[2]t
+}
+`, port, protected)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cenkalti/backoff/v5"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+// isHttpVirtualServiceType returns whether virtual services of the type
+// process HTTP.
+func isHttpVirtualServiceType(vsType string) bool {
+	return vsType == "http" || vsType == "http2"
+}
+
+// isLayer7VirtualService returns whether the virtual service inspects the
+// requests, which is required by content rules, the WAF and the Edge Security
+// Pack. Virtual services of other types only do so with SSL offloading.
+func isLayer7VirtualService(vs *api.VirtualServiceResponse) bool {
+	return isHttpVirtualServiceType(vs.VSType) || (vs.SSLAcceleration != nil && *vs.SSLAcceleration)
+}
+
+// validateVirtualServiceProtocol checks the type of a virtual service against
+// its protocol, HTTP is only served over TCP.
+func validateVirtualServiceProtocol(protocol types.String, vsType types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if protocol.ValueString() == "udp" && isHttpVirtualServiceType(vsType.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Invalid Virtual Service Type",
+			fmt.Sprintf("Virtual services with protocol `udp` cannot be of type `%s`.", vsType.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// warnVirtualServiceTypeChange warns when the type of an existing virtual
+// service changes, as the LoadMaster resets its layer 7 settings.
+func warnVirtualServiceTypeChange(state types.String, plan types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if state.IsNull() || state.IsUnknown() || plan.IsUnknown() || plan.IsNull() || state.Equal(plan) {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("type"),
+		"Virtual Service Type Change",
+		fmt.Sprintf("Changing the type from `%s` to `%s` resets the layer 7 settings of the virtual service on the LoadMaster, "+
+			"such as its content rules, WAF rules and Edge Security Pack settings. "+
+			"Settings managed by other resources are restored on the next apply.", state.ValueString(), plan.ValueString()),
+	)
+
+	return diags
+}
+
+// showPlannedVirtualService reads the virtual service with the id during plan
+// or apply. It returns nil if the id is not known yet, e.g. because the
+// virtual service is created in the same apply, or if it cannot be read.
+func showPlannedVirtualService(ctx context.Context, client *api.Client, id types.String) *api.VirtualServiceResponse {
+	if client == nil || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+
+	operation := ClientBackoff(func() (*api.VirtualServiceResponse, error) {
+		return client.ShowVirtualService(id.ValueString())
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		tflog.Warn(ctx, "Unable to read virtual service, skipping the type check", map[string]any{"error": err.Error()})
+		return nil
+	}
+
+	return response
+}
+
+// warnLayer7VirtualService warns during plan when the virtual service with the
+// id does not inspect requests, as the feature requires. The plan of the
+// virtual service is not visible here, its type may still change in the same
+// apply, so requireLayer7VirtualService enforces it before the create.
+func warnLayer7VirtualService(ctx context.Context, client *api.Client, id types.String, feature string, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vs := showPlannedVirtualService(ctx, client, id)
+	if vs == nil || isLayer7VirtualService(vs) {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		attribute,
+		"Possible Unsupported Virtual Service Type",
+		fmt.Sprintf("The %s requires a virtual service of type `http` or `http2` or with SSL offloading, "+
+			"but virtual service %s is of type `%s` yet. This is expected if its type is changed in the same apply, "+
+			"otherwise the create fails.", feature, id.ValueString(), vs.VSType),
+	)
+
+	return diags
+}
+
+// requireLayer7VirtualService checks before a create that the virtual service
+// with the id inspects requests, as the feature requires. At this point
+// changes to the virtual service in the same apply are already made.
+func requireLayer7VirtualService(ctx context.Context, client *api.Client, id types.String, feature string, attribute path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	vs := showPlannedVirtualService(ctx, client, id)
+	if vs == nil || isLayer7VirtualService(vs) {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Unsupported Virtual Service Type",
+		fmt.Sprintf("The %s requires a virtual service of type `http` or `http2` or with SSL offloading, "+
+			"but virtual service %s is of type `%s`.", feature, id.ValueString(), vs.VSType),
+	)
+
+	return diags
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestIsLayer7VirtualService(t *testing.T) {
+	offloaded := true
+
+	for _, tc := range []struct {
+		vs   api.VirtualServiceResponse
+		want bool
+	}{
+		{api.VirtualServiceResponse{VSType: "http"}, true},
+		{api.VirtualServiceResponse{VSType: "http2"}, true},
+		{api.VirtualServiceResponse{VSType: "gen"}, false},
+		{api.VirtualServiceResponse{VSType: "gen", SSLAcceleration: &offloaded}, true},
+	} {
+		if got := isLayer7VirtualService(&tc.vs); got != tc.want {
+			t.Errorf("isLayer7VirtualService(%s) = %t, want %t", tc.vs.VSType, got, tc.want)
+		}
+	}
+}
+
+func TestValidateVirtualServiceProtocol(t *testing.T) {
+	for _, tc := range []struct {
+		protocol types.String
+		vsType   types.String
+		errors   int
+	}{
+		{types.StringValue("tcp"), types.StringValue("http"), 0},
+		{types.StringValue("udp"), types.StringValue("gen"), 0},
+		{types.StringValue("udp"), types.StringValue("http"), 1},
+		{types.StringValue("udp"), types.StringValue("http2"), 1},
+		{types.StringValue("udp"), types.StringNull(), 0},
+		{types.StringUnknown(), types.StringValue("http"), 0},
+	} {
+		if diags := validateVirtualServiceProtocol(tc.protocol, tc.vsType); diags.ErrorsCount() != tc.errors {
+			t.Errorf("%s/%s: expected %d errors, got: %v", tc.protocol, tc.vsType, tc.errors, diags)
+		}
+	}
+}
+
+func TestWarnVirtualServiceTypeChange(t *testing.T) {
+	for _, tc := range []struct {
+		state    types.String
+		plan     types.String
+		warnings int
+	}{
+		{types.StringValue("http"), types.StringValue("gen"), 1},
+		{types.StringValue("http"), types.StringValue("http"), 0},
+		{types.StringNull(), types.StringValue("http"), 0},
+		{types.StringValue("http"), types.StringUnknown(), 0},
+	} {
+		if diags := warnVirtualServiceTypeChange(tc.state, tc.plan); diags.WarningsCount() != tc.warnings {
+			t.Errorf("%s to %s: expected %d warnings, got: %v", tc.state, tc.plan, tc.warnings, diags)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/cenkalti/backoff/v5"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+var (
+	_ datasource.DataSource              = &VirtualServicesDataSource{}
+	_ datasource.DataSourceWithConfigure = &VirtualServicesDataSource{}
+)
+
+func NewVirtualServicesDataSource() datasource.DataSource {
+	return &VirtualServicesDataSource{}
+}
+
+type VirtualServicesDataSource struct {
+	client *api.Client
+}
+
+type VirtualServicesDataSourceModel struct {
+	Nickname        types.String                `tfsdk:"nickname"`
+	NicknameRegex   types.String                `tfsdk:"nickname_regex"`
+	Address         types.String                `tfsdk:"address"`
+	Port            types.String                `tfsdk:"port"`
+	Protocol        types.String                `tfsdk:"protocol"`
+	Type            types.String                `tfsdk:"type"`
+	Enabled         types.Bool                  `tfsdk:"enabled"`
+	ParentId        types.String                `tfsdk:"parent_id"`
+	VirtualServices []VirtualServicesEntryModel `tfsdk:"virtual_services"`
+}
+
+type VirtualServicesEntryModel struct {
+	Id       types.String `tfsdk:"id"`
+	ParentId types.String `tfsdk:"parent_id"`
+	Address  types.String `tfsdk:"address"`
+	Port     types.String `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Type     types.String `tfsdk:"type"`
+	Nickname types.String `tfsdk:"nickname"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *VirtualServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_services"
+}
+
+func (d *VirtualServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the virtual services and sub virtual services of the LoadMaster. All filters are optional and combined, the virtual services are sorted by their id.",
+
+		Attributes: map[string]schema.Attribute{
+			"nickname": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this nickname.",
+				Optional:            true,
+			},
+			"nickname_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services whose nickname matches this regular expression.",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this address.",
+				Optional:            true,
+				Validators:          ipAddress(),
+			},
+			"port": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this port.",
+				Optional:            true,
+				Validators:          port(true),
+			},
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services with this protocol, either `tcp` or `udp`.",
+				Optional:            true,
+				Validators:          oneOf(virtualServiceProtocols...),
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list virtual services of this type, either `gen`, `http`, `http2`, `ts`, `tls` or `log`.",
+				Optional:            true,
+				Validators:          oneOf(virtualServiceTypes...),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Only list enabled or disabled virtual services.",
+				Optional:            true,
+			},
+			"parent_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the sub virtual services of this virtual service.",
+				Optional:            true,
+			},
+			"virtual_services": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching virtual services.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The virtual service id. This is also called `Index` in the LoadMaster API.",
+							Computed:            true,
+						},
+						"parent_id": schema.StringAttribute{
+							MarkdownDescription: "The id of the parent virtual service, only set for sub virtual services.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The address of the virtual service.",
+							Computed:            true,
+						},
+						"port": schema.StringAttribute{
+							MarkdownDescription: "The port of the virtual service.",
+							Computed:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "The protocol of the virtual service.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the virtual service.",
+							Computed:            true,
+						},
+						"nickname": schema.StringAttribute{
+							MarkdownDescription: "The nickname of the virtual service.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "If the virtual service is enabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VirtualServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VirtualServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VirtualServicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	nicknameRegex, diags := listFilterRegexp(data.NicknameRegex, "nickname_regex")
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operation := ClientBackoff(func() (*api.ListVirtualServiceResponse, error) {
+		return d.client.ListVirtualServices()
+	})
+	response, err := backoff.Retry(ctx, operation, backoff.WithBackOff(backoff.NewExponentialBackOff()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list virtual services, got error: %s", err))
+		return
+	}
+
+	tflog.SetField(ctx, "response", response)
+	tflog.Trace(ctx, "Received valid response from API")
+
+	data.VirtualServices = filterVirtualServices(response.VS, data, nicknameRegex)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// filterVirtualServices returns the virtual services matching the filters of
+// the data source, sorted by their index.
+func filterVirtualServices(virtualServices []api.VirtualServiceResponse, data VirtualServicesDataSourceModel, nicknameRegex *regexp.Regexp) []VirtualServicesEntryModel {
+	virtualServices = append([]api.VirtualServiceResponse{}, virtualServices...)
+	sort.Slice(virtualServices, func(i, j int) bool {
+		return virtualServices[i].Index < virtualServices[j].Index
+	})
+
+	entries := []VirtualServicesEntryModel{}
+	for _, vs := range virtualServices {
+		parentId := types.StringNull()
+		if vs.MasterVSID != 0 {
+			parentId = types.StringValue(strconv.Itoa(int(vs.MasterVSID)))
+		}
+
+		enabled := vs.Enable != nil && *vs.Enable
+
+		if !listFilterMatches(data.Nickname, vs.NickName) || !nicknameRegex.MatchString(vs.NickName) ||
+			!listFilterMatches(data.Address, vs.Address) || !listFilterMatches(data.Port, vs.Port) ||
+			!listFilterMatches(data.Protocol, vs.Protocol) || !listFilterMatches(data.Type, vs.VSType) ||
+			(!data.Enabled.IsNull() && data.Enabled.ValueBool() != enabled) ||
+			(!data.ParentId.IsNull() && !data.ParentId.Equal(parentId)) {
+			continue
+		}
+
+		entries = append(entries, VirtualServicesEntryModel{
+			Id:       types.StringValue(strconv.Itoa(int(vs.Index))),
+			ParentId: parentId,
+			Address:  types.StringValue(vs.Address),
+			Port:     types.StringValue(vs.Port),
+			Protocol: types.StringValue(vs.Protocol),
+			Type:     types.StringValue(vs.VSType),
+			Nickname: types.StringValue(vs.NickName),
+			Enabled:  types.BoolValue(enabled),
+		})
+	}
+
+	return entries
+}
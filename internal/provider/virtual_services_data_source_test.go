@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/kreemer/loadmaster-go-client/api"
+)
+
+func TestVirtualServicesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testVirtualServicesDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.loadmaster_virtual_services.test",
+						tfjsonpath.New("virtual_services"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"nickname": knownvalue.StringExact("dashboard-a"),
+								"port":     knownvalue.StringExact("9091"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"nickname": knownvalue.StringExact("dashboard-b"),
+								"port":     knownvalue.StringExact("9092"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testVirtualServicesDataSourceConfig = `
+resource "loadmaster_virtual_service" "a" {
+	address = "10.0.0.4"
+	port = "9091"
+	protocol = "tcp"
+	nickname = "dashboard-a"
+}
+
+resource "loadmaster_virtual_service" "b" {
+	address = "10.0.0.4"
+	port = "9092"
+	protocol = "tcp"
+	nickname = "dashboard-b"
+}
+
+data "loadmaster_virtual_services" "test" {
+	nickname_regex = "^dashboard-"
+
+	depends_on = [loadmaster_virtual_service.a, loadmaster_virtual_service.b]
+}
+`
+
+func TestFilterVirtualServices(t *testing.T) {
+	enabled, disabled := true, false
+	virtualServices := []api.VirtualServiceResponse{
+		{Index: 3, Address: "10.0.0.4", Port: "443", Protocol: "tcp", VSType: "http", NickName: "web", Enable: &enabled},
+		{Index: 1, Address: "10.0.0.4", Port: "80", Protocol: "tcp", VSType: "http", NickName: "web-plain", Enable: &disabled},
+		{Index: 2, Address: "10.0.0.5", Port: "53", Protocol: "udp", VSType: "gen", NickName: "dns", Enable: &enabled},
+		{Index: 4, MasterVSID: 3, VSType: "http", NickName: "web-api", Enable: &enabled},
+	}
+
+	all := regexp.MustCompile("")
+
+	tests := []struct {
+		name  string
+		data  VirtualServicesDataSourceModel
+		regex *regexp.Regexp
+		want  []string
+	}{
+		{"all sorted", VirtualServicesDataSourceModel{}, all, []string{"1", "2", "3", "4"}},
+		{"nickname", VirtualServicesDataSourceModel{Nickname: types.StringValue("web")}, all, []string{"3"}},
+		{"nickname regex", VirtualServicesDataSourceModel{}, regexp.MustCompile("^web"), []string{"1", "3", "4"}},
+		{"address", VirtualServicesDataSourceModel{Address: types.StringValue("10.0.0.4")}, all, []string{"1", "3"}},
+		{"protocol", VirtualServicesDataSourceModel{Protocol: types.StringValue("udp")}, all, []string{"2"}},
+		{"type and enabled", VirtualServicesDataSourceModel{Type: types.StringValue("http"), Enabled: types.BoolValue(true)}, all, []string{"3", "4"}},
+		{"parent", VirtualServicesDataSourceModel{ParentId: types.StringValue("3")}, all, []string{"4"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := filterVirtualServices(virtualServices, tc.data, tc.regex)
+
+			got := []string{}
+			for _, entry := range entries {
+				got = append(got, entry.Id.ValueString())
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
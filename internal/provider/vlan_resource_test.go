@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestVlanResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testVlanResourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"loadmaster_vlan.test",
+						tfjsonpath.New("vlan_id"),
+						knownvalue.Int32Exact(100),
+					),
+					statecheck.ExpectKnownValue(
+						"loadmaster_vlan.test",
+						tfjsonpath.New("vlan_interface_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			{
+				ResourceName:      "loadmaster_vlan.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testVlanResourceConfig = `
+resource "loadmaster_vlan" "test" {
+  interface_id = 0
+  vlan_id = 100
+}
+`